@@ -0,0 +1,14 @@
+data "aiven_clouds" "google" {
+  provider_name = "google"
+
+  # Helsinki
+  latitude  = 60.17
+  longitude = 24.94
+}
+
+resource "aiven_pg" "pg" {
+  project      = aiven_project.project.project
+  service_name = "pg"
+  cloud_name   = data.aiven_clouds.google.nearest_cloud_name
+  plan         = "startup-4"
+}
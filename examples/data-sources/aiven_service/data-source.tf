@@ -0,0 +1,4 @@
+data "aiven_service" "pg" {
+  project      = data.aiven_project.pr1.project
+  service_name = "my-pg1"
+}
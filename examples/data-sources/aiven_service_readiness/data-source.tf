@@ -0,0 +1,5 @@
+data "aiven_service_readiness" "pg" {
+  project        = aiven_pg.pg.project
+  service_name   = aiven_pg.pg.service_name
+  wait_for_state = "running"
+}
@@ -0,0 +1,10 @@
+data "aiven_services" "team_pg" {
+  project      = aiven_project.project.project
+  service_type = "pg"
+  state        = "RUNNING"
+
+  tag {
+    key   = "team"
+    value = "payments"
+  }
+}
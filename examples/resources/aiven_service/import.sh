@@ -0,0 +1 @@
+terraform import aiven_service.pg project/service_name
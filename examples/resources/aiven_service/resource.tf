@@ -0,0 +1,17 @@
+resource "aiven_service" "pg" {
+  project                 = data.aiven_project.pr1.project
+  cloud_name              = "google-europe-west1"
+  plan                    = "startup-4"
+  service_name            = "my-pg1"
+  service_type            = "pg"
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+
+  user_config = jsonencode({
+    pg_version = "15"
+
+    pg = {
+      idle_in_transaction_session_timeout = 900
+    }
+  })
+}
@@ -1,6 +1,7 @@
 package acctest
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -10,24 +11,59 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	frameworkprovider "github.com/aiven/terraform-provider-aiven/internal/provider"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
 var (
-	TestAccProvider          *schema.Provider
+	TestAccProvider *schema.Provider
+	// testAccTokenCache is shared by the SDK and the framework providers, as in the provider binary.
+	testAccTokenCache        = common.NewTokenCache()
 	TestAccProviderFactories map[string]func() (*schema.Provider, error)
+
+	// TestProtoV6ProviderFactories serve the SDK and the framework providers through a mux server,
+	// as the provider binary does. Tests using resources of the framework provider need them.
+	TestProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 )
 
 func init() {
-	TestAccProvider = provider.Provider("test")
+	TestAccProvider = provider.Provider("test", testAccTokenCache)
 	TestAccProviderFactories = map[string]func() (*schema.Provider, error){
 		"aiven": func() (*schema.Provider, error) {
 			return TestAccProvider, nil
 		},
 	}
+	TestProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"aiven": func() (tfprotov6.ProviderServer, error) {
+			ctx := context.Background()
+
+			sdkProvider, err := tf5to6server.UpgradeServer(ctx, TestAccProvider.GRPCProvider)
+			if err != nil {
+				return nil, err
+			}
+
+			muxServer, err := tf6muxserver.NewMuxServer(
+				ctx,
+				func() tfprotov6.ProviderServer {
+					return sdkProvider
+				},
+				providerserver.NewProtocol6(frameworkprovider.New("test", testAccTokenCache)()),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return common.NewSafetyServer(muxServer.ProviderServer()), nil
+		},
+	}
 }
 
 func TestAccPreCheck(t *testing.T) {
@@ -44,7 +80,7 @@ func TestAccPreCheck(t *testing.T) {
 }
 
 func TestAccCheckAivenServiceResourceDestroy(s *terraform.State) error {
-	c := TestAccProvider.Meta().(*aiven.Client)
+	c := TestAccProvider.Meta().(*common.ProviderMeta).Client
 	// loop through the resources in state, verifying each service is destroyed
 	for n, rs := range s.RootModule().Resources {
 		// ignore datasource
@@ -1,8 +1,11 @@
 package acctest
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/provider"
 )
 
@@ -10,11 +13,35 @@ import (
 const version = "test"
 
 func TestProvider(t *testing.T) {
-	if err := provider.Provider(version).InternalValidate(); err != nil {
+	if err := provider.Provider(version, nil).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
 func TestProviderImpl(*testing.T) {
-	var _ = provider.Provider(version)
+	var _ = provider.Provider(version, nil)
+}
+
+// TestMuxServer checks that the SDK and the framework providers can be served together, which requires
+// identical provider schemas and no resource or data source served by both.
+func TestMuxServer(t *testing.T) {
+	server, err := TestProtoV6ProviderFactories["aiven"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	for _, name := range []string{"aiven_organization", "aiven_organizational_unit", "aiven_project"} {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("resource %s is not served", name)
+		}
+	}
 }
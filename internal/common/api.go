@@ -0,0 +1,69 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+)
+
+// apiBaseURL returns the API base URL known to the Aiven client, read from AIVEN_WEB_URL on startup.
+func apiBaseURL() string {
+	if v, ok := os.LookupEnv("AIVEN_WEB_URL"); ok {
+		return v
+	}
+
+	return defaultAPIURL
+}
+
+// DoAPIRequest sends a request to an Aiven API endpoint the Aiven client has no method for, e.g.
+// POST /v1/project/{project}/service/{service}/logs. It goes through the HTTP client of the Aiven client, so
+// the api_url, retry and logging settings apply. The request body is encoded from req and the response body
+// decoded into rsp, when they are not nil. Unsuccessful responses are returned as aiven.Error, like the Aiven
+// client does. The path is sent as is, so the names in it must be escaped with url.PathEscape.
+func DoAPIRequest(ctx context.Context, client *aiven.Client, method, path string, req, rsp interface{}) error {
+	var body io.Reader
+	if req != nil {
+		b, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(b)
+	}
+
+	r, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(apiBaseURL(), "/")+path, body)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("User-Agent", client.UserAgent)
+	r.Header.Set("Authorization", "aivenv1 "+client.APIKey)
+
+	res, err := client.Client.Do(r)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return aiven.Error{Message: string(b), Status: res.StatusCode}
+	}
+
+	if rsp == nil || len(b) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(b, rsp)
+}
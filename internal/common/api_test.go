@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoAPIRequest(t *testing.T) {
+	var gotPath, gotAuth string
+
+	var gotBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotAuth = r.URL.EscapedPath(), r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+
+		if r.URL.Path == "/v1/project/p/service/missing/logs" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Service not found"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"logs": [{"msg": "started"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewCustomAivenClient("token", "", "", ClientOptions{APIURL: server.URL})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var rsp struct {
+		Logs []struct {
+			Msg string `json:"msg"`
+		} `json:"logs"`
+	}
+
+	err = DoAPIRequest(context.Background(), client, "POST", "/v1/project/p/service/s/logs", map[string]int{"limit": 5}, &rsp)
+	assert.NoError(t, err)
+	assert.Equal(t, "/v1/project/p/service/s/logs", gotPath)
+	assert.Equal(t, "aivenv1 token", gotAuth)
+	assert.Equal(t, float64(5), gotBody["limit"])
+	assert.Equal(t, "started", rsp.Logs[0].Msg)
+
+	err = DoAPIRequest(context.Background(), client, "POST", "/v1/project/p/service/missing/logs", nil, &rsp)
+	assert.True(t, aiven.IsNotFound(err))
+
+	path := fmt.Sprintf("/v1/project/%s/service/%s/logs", url.PathEscape("p"), url.PathEscape("a/b c"))
+	err = DoAPIRequest(context.Background(), client, "POST", path, nil, &rsp)
+	assert.NoError(t, err)
+	assert.Equal(t, "/v1/project/p/service/a%2Fb%20c/logs", gotPath)
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// LookupCache caches the results of read-only Aiven API lookups, e.g. service plans and pricing, for the lifetime
+// of a provider instance. Terraform starts the provider for every plan or apply, so the cached values live as long
+// as one of them. Concurrent lookups of the same key share a single API call.
+type LookupCache struct {
+	group singleflight.Group
+
+	// mu guards values.
+	mu     sync.RWMutex
+	values map[string]interface{}
+}
+
+// NewLookupCache returns an empty lookup cache.
+func NewLookupCache() *LookupCache {
+	return &LookupCache{values: make(map[string]interface{})}
+}
+
+// LookupKey builds a cache key from the kind of the lookup and its parameters. The parameters are quoted, so that
+// different parameters never build the same key.
+func LookupKey(kind string, params ...string) string {
+	var b strings.Builder
+
+	b.WriteString(kind)
+
+	for _, p := range params {
+		b.WriteByte('/')
+		b.WriteString(strconv.Quote(p))
+	}
+
+	return b.String()
+}
+
+// Invalidate removes the cached value of the key, the next lookup calls the API again.
+func (c *LookupCache) Invalidate(key string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.values, key)
+}
+
+// get returns the cached value of the key, calling fetch if there is none. Errors are not cached.
+func (c *LookupCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	c.mu.RLock()
+	v, ok := c.values[key]
+	c.mu.RUnlock()
+
+	if ok {
+		return v, nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		v, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.values[key] = v
+
+		return v, nil
+	})
+
+	return v, err
+}
+
+// CachedLookup returns the cached value of the key, calling fetch if there is none. A nil cache calls fetch every time.
+func CachedLookup[T any](c *LookupCache, key string, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	v, err := c.get(key, func() (interface{}, error) {
+		return fetch()
+	})
+	if err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return v.(T), nil
+}
+
+// GetLookupCache returns the lookup cache of the provider instance the given provider meta belongs to, nil if there
+// is none.
+func GetLookupCache(m interface{}) *LookupCache {
+	if meta, ok := m.(*ProviderMeta); ok {
+		return meta.Lookups
+	}
+
+	return nil
+}
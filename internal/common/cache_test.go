@@ -0,0 +1,84 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupKey(t *testing.T) {
+	assert.NotEqual(t, LookupKey("services", "ab", "c"), LookupKey("services", "a", "bc"))
+	assert.NotEqual(t, LookupKey("services", "a/b"), LookupKey("services", "a", "b"))
+	assert.Equal(t, LookupKey("services", "a", "b"), LookupKey("services", "a", "b"))
+}
+
+func TestCachedLookup(t *testing.T) {
+	cache := NewLookupCache()
+
+	var calls int32
+
+	fetch := func() (string, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			v, err := CachedLookup(cache, "key", fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, "value", v)
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	cache.Invalidate("key")
+
+	v, err := CachedLookup(cache, "key", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", v)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestCachedLookupError(t *testing.T) {
+	cache := NewLookupCache()
+
+	_, err := CachedLookup(cache, "key", func() (int, error) {
+		return 0, errors.New("unavailable")
+	})
+	assert.EqualError(t, err, "unavailable")
+
+	// Errors are not cached.
+	v, err := CachedLookup(cache, "key", func() (int, error) {
+		return 42, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, v)
+}
+
+func TestCachedLookupNilCache(t *testing.T) {
+	var calls int
+
+	for i := 0; i < 2; i++ {
+		_, _ = CachedLookup(nil, "key", func() (int, error) {
+			calls++
+
+			return calls, nil
+		})
+	}
+
+	assert.Equal(t, 2, calls)
+}
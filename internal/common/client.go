@@ -1,21 +1,64 @@
 package common
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/go-cleanhttp"
 )
 
+// defaultAPIURL is the base URL of the Aiven API used by the Aiven client when AIVEN_WEB_URL is not set.
+const defaultAPIURL = "https://api.aiven.io"
+
+// ClientOptions are the optional settings of the HTTP transport used by the Aiven client.
+type ClientOptions struct {
+	// APIURL is the base URL of the Aiven API, e.g. https://api.aiven.io.
+	APIURL string
+	// CABundle is a PEM encoded bundle of CA certificates to trust in addition to the system ones.
+	CABundle string
+	// CABundleFile is a path to a PEM encoded bundle of CA certificates to trust in addition to the system ones.
+	CABundleFile string
+	// InsecureSkipVerify disables TLS certificate verification. It should only be used for testing.
+	InsecureSkipVerify bool
+	// HTTPProxy is the URL of the proxy used for all requests. When empty, the standard proxy
+	// environment variables are used.
+	HTTPProxy string
+	// MaxRequestsPerSecond limits the rate of requests sent to the Aiven API. Zero means no limit.
+	MaxRequestsPerSecond float64
+	// MaxRetries is the number of times a request is retried after a 429, 502, 503 or 504 response.
+	MaxRetries int
+}
+
+var (
+	// sharedClients are the clients created by NewSharedAivenClient.
+	sharedClients   = make(map[sharedClientKey]*aiven.Client)
+	sharedClientsMu sync.Mutex
+)
+
+// sharedClientKey are the arguments a shared client was created with.
+type sharedClientKey struct {
+	token        string
+	tfVersion    string
+	buildVersion string
+	opts         ClientOptions
+}
+
 func NewAivenClient() (*aiven.Client, error) {
 	return NewAivenClientWithToken(os.Getenv("AIVEN_TOKEN"))
 }
 
 func NewAivenClientWithToken(token string) (*aiven.Client, error) {
-	return NewCustomAivenClient(token, "", "")
+	return NewCustomAivenClient(token, "", "", ClientOptions{MaxRetries: DefaultMaxRetries})
 }
 
-func NewCustomAivenClient(token, tfVersion, buildVersion string) (*aiven.Client, error) {
+func NewCustomAivenClient(token, tfVersion, buildVersion string, opts ClientOptions) (*aiven.Client, error) {
 	if token == "" {
 		return nil, fmt.Errorf("token is required for Aiven client")
 	}
@@ -30,5 +73,162 @@ func NewCustomAivenClient(token, tfVersion, buildVersion string) (*aiven.Client,
 		buildVersion = "dev"
 	}
 
-	return aiven.NewTokenClient(token, fmt.Sprintf("terraform-provider-aiven/%s/%s", tfVersion, buildVersion))
+	httpClient, err := newHTTPClient(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := aiven.NewTokenClient(token, fmt.Sprintf("terraform-provider-aiven/%s/%s", tfVersion, buildVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	client.Client = httpClient
+
+	return client, nil
+}
+
+// NewSharedAivenClient returns the client created by an earlier call with the same arguments, or creates one.
+// The SDK and the framework providers served by the same process are configured separately, this lets them
+// share one client, and so one rate limit.
+func NewSharedAivenClient(token, tfVersion, buildVersion string, opts ClientOptions) (*aiven.Client, error) {
+	key := sharedClientKey{token: token, tfVersion: tfVersion, buildVersion: buildVersion, opts: opts}
+
+	sharedClientsMu.Lock()
+	defer sharedClientsMu.Unlock()
+
+	if client, ok := sharedClients[key]; ok {
+		return client, nil
+	}
+
+	client, err := NewCustomAivenClient(token, tfVersion, buildVersion, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedClients[key] = client
+
+	return client, nil
+}
+
+// newHTTPClient builds the HTTP client used by the Aiven client from the given options.
+func newHTTPClient(opts ClientOptions) (*http.Client, error) {
+	transport := cleanhttp.DefaultPooledTransport()
+
+	tlsConfig, err := newTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if opts.HTTPProxy != "" {
+		proxyURL, err := url.Parse(opts.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http_proxy %q: %w", opts.HTTPProxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var rt http.RoundTripper = newLoggingTransport(transport)
+
+	if opts.APIURL != "" {
+		rt, err = newAPIURLTransport(rt, opts.APIURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rt = newRetryTransport(rt, opts.MaxRequestsPerSecond, opts.MaxRetries)
+
+	return &http.Client{Transport: rt}, nil
+}
+
+// newTLSConfig builds the TLS configuration trusting the system CA certificates and the configured CA bundles.
+func newTLSConfig(opts ClientOptions) (*tls.Config, error) {
+	// nolint:gosec // InsecureSkipVerify is an explicit opt-in meant for testing only.
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	var bundles [][]byte
+
+	if opts.CABundle != "" {
+		bundles = append(bundles, []byte(opts.CABundle))
+	}
+
+	if opts.CABundleFile != "" {
+		b, err := os.ReadFile(opts.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_bundle_file %q: %w", opts.CABundleFile, err)
+		}
+
+		bundles = append(bundles, b)
+	}
+
+	if len(bundles) == 0 {
+		return tlsConfig, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	for _, b := range bundles {
+		if ok := pool.AppendCertsFromPEM(b); !ok {
+			return nil, fmt.Errorf("no valid PEM encoded certificates found in the CA bundle")
+		}
+	}
+
+	tlsConfig.RootCAs = pool
+
+	return tlsConfig, nil
+}
+
+// apiURLTransport redirects the requests made by the Aiven client to a custom API base URL.
+type apiURLTransport struct {
+	next   http.RoundTripper
+	origin *url.URL
+	target *url.URL
+}
+
+// newAPIURLTransport returns a transport that sends the requests addressed to the API base URL known to
+// the Aiven client to apiURL instead.
+func newAPIURLTransport(next http.RoundTripper, apiURL string) (*apiURLTransport, error) {
+	target, err := url.Parse(strings.TrimSuffix(apiURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid api_url %q: %w", apiURL, err)
+	}
+
+	if target.Scheme == "" || target.Host == "" {
+		return nil, fmt.Errorf("invalid api_url %q: scheme and host are required", apiURL)
+	}
+
+	originURL := apiBaseURL()
+
+	origin, err := url.Parse(strings.TrimSuffix(originURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid AIVEN_WEB_URL %q: %w", originURL, err)
+	}
+
+	return &apiURLTransport{next: next, origin: origin, target: target}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *apiURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.origin.Host || !strings.HasPrefix(req.URL.Path, t.origin.Path) {
+		return t.next.RoundTrip(req)
+	}
+
+	// RoundTrip must not modify the original request.
+	r := req.Clone(req.Context())
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	// The escaped path is kept, so that escaped slashes in names are not sent as path separators.
+	r.URL.Path = t.target.Path + strings.TrimPrefix(req.URL.Path, t.origin.Path)
+	r.URL.RawPath = t.target.EscapedPath() + strings.TrimPrefix(req.URL.EscapedPath(), t.origin.EscapedPath())
+	r.Host = ""
+
+	return t.next.RoundTrip(r)
 }
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCustomAivenClientAPIURL(t *testing.T) {
+	var gotPath string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		_, _ = w.Write([]byte(`{"projects": []}`))
+	}))
+	defer server.Close()
+
+	client, err := NewCustomAivenClient("token", "", "", ClientOptions{APIURL: server.URL + "/api/"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = client.Projects.List()
+	assert.NoError(t, err)
+	assert.Equal(t, "/api/v1/project", gotPath)
+
+	// The escaped names are sent as is.
+	_, err = client.Projects.Get("a/b")
+	assert.NoError(t, err)
+	assert.Equal(t, "/api/v1/project/a%2Fb", gotPath)
+}
+
+func TestNewCustomAivenClientOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ClientOptions
+		wantErr bool
+	}{
+		{
+			"defaults",
+			ClientOptions{},
+			false,
+		},
+		{
+			"api_url_without_scheme",
+			ClientOptions{APIURL: "api.aiven.io"},
+			true,
+		},
+		{
+			"invalid_ca_bundle",
+			ClientOptions{CABundle: "not a certificate"},
+			true,
+		},
+		{
+			"missing_ca_bundle_file",
+			ClientOptions{CABundleFile: "/nonexistent/ca.pem"},
+			true,
+		},
+		{
+			"invalid_http_proxy",
+			ClientOptions{HTTPProxy: "://proxy"},
+			true,
+		},
+		{
+			"http_proxy",
+			ClientOptions{HTTPProxy: "http://proxy.example.com:3128", InsecureSkipVerify: true},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCustomAivenClient("token", "", "", tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCustomAivenClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package common
+
+// ProviderConfig is the provider level configuration that resources need besides the Aiven client.
+type ProviderConfig struct {
+	// DefaultTags are merged into the tags of every taggable resource. Resource level tags take precedence.
+	DefaultTags map[string]string
+	// Project is the project of the resources and data sources that do not set one.
+	Project string
+	// MaxMonthlyCostUSD is the maximum estimated monthly cost of the services in the configuration, 0 means no limit.
+	MaxMonthlyCostUSD float64
+}
+
+// GetProviderConfig returns the configuration of the provider instance the given provider meta belongs to.
+// An empty configuration is returned if there is none.
+func GetProviderConfig(m interface{}) *ProviderConfig {
+	if meta, ok := m.(*ProviderMeta); ok && meta.Config != nil {
+		return meta.Config
+	}
+
+	return &ProviderConfig{}
+}
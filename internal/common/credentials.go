@@ -0,0 +1,202 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultProfile is the name of the profile used when none is set.
+const DefaultProfile = "default"
+
+// tokenCommandTimeout is the maximum time an api_token_command may run.
+const tokenCommandTimeout = 1 * time.Minute
+
+// errTokenNotSet is returned when no token source is configured.
+var errTokenNotSet = errors.New(
+	"api token was not set, use one of api_token, api_token_file, api_token_command or api_token_profile",
+)
+
+// TokenCache caches the tokens returned by api_token_command, by command. It is shared by the SDK and the framework
+// providers of a provider instance, so that the command runs once per instance.
+type TokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+// NewTokenCache returns an empty TokenCache.
+func NewTokenCache() *TokenCache {
+	return &TokenCache{tokens: make(map[string]string)}
+}
+
+// TokenOptions are the sources an Aiven API token can be read from, in order of precedence.
+type TokenOptions struct {
+	// Token is the token itself.
+	Token string
+	// TokenFile is a path to a file containing the token.
+	TokenFile string
+	// TokenCommand is a command printing the token to its standard output.
+	TokenCommand string
+	// Profile is the name of a profile in the credentials file.
+	Profile string
+	// CredentialsFile is the path to the credentials file. When empty, DefaultCredentialsFile is used if Profile is
+	// set.
+	CredentialsFile string
+	// Cache caches the token returned by TokenCommand. When nil, the command runs every time.
+	Cache *TokenCache
+}
+
+// credentials is a single set of credentials in the credentials file, in the format used by the Aiven CLI.
+type credentials struct {
+	AuthToken string `json:"auth_token"`
+}
+
+// DefaultCredentialsFile returns the path of the credentials file used by the Aiven CLI.
+func DefaultCredentialsFile() string {
+	if v := os.Getenv("AIVEN_CREDENTIALS_FILE"); v != "" {
+		return v
+	}
+
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+
+		configDir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(configDir, "aiven", "aiven-credentials.json")
+}
+
+// ResolveToken returns the Aiven API token from the first configured source. The credentials file is only read
+// when the profile or the credentials file is set, so that a token of the Aiven CLI is never picked up unasked.
+func ResolveToken(ctx context.Context, opts TokenOptions) (string, error) {
+	switch {
+	case opts.Token != "":
+		return opts.Token, nil
+	case opts.TokenFile != "":
+		return tokenFromFile(opts.TokenFile)
+	case opts.TokenCommand != "":
+		return tokenFromCommand(ctx, opts.TokenCommand, opts.Cache)
+	}
+
+	path := opts.CredentialsFile
+	if path == "" {
+		path = os.Getenv("AIVEN_CREDENTIALS_FILE")
+	}
+
+	if path == "" && opts.Profile == "" {
+		return "", errTokenNotSet
+	}
+
+	if path == "" {
+		path = DefaultCredentialsFile()
+	}
+
+	return tokenFromProfile(path, opts.Profile)
+}
+
+// tokenFromFile reads the token from the given file, ignoring the surrounding whitespace.
+func tokenFromFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read api_token_file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("api_token_file %q is empty", path)
+	}
+
+	return token, nil
+}
+
+// tokenFromCommand runs the given command with the system shell and returns its standard output.
+// The token is kept in the cache, if any, so the command runs only once per cache.
+func tokenFromCommand(ctx context.Context, command string, cache *TokenCache) (string, error) {
+	if cache != nil {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+
+		if token, ok := cache.tokens[command]; ok {
+			return token, nil
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("api_token_command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("api_token_command returned an empty token")
+	}
+
+	if cache != nil {
+		cache.tokens[command] = token
+	}
+
+	return token, nil
+}
+
+// tokenFromProfile reads the token of the given profile from the credentials file. The file can either
+// hold a single set of credentials, as written by the Aiven CLI, which is the default profile, or an
+// object of named profiles.
+func tokenFromProfile(path, profile string) (string, error) {
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read credentials file: %w", err)
+	}
+
+	var profiles map[string]json.RawMessage
+	if err := json.Unmarshal(b, &profiles); err != nil {
+		return "", fmt.Errorf("unable to parse credentials file %q: %w", path, err)
+	}
+
+	var c credentials
+
+	if raw, ok := profiles[profile]; ok {
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return "", fmt.Errorf("unable to parse profile %q in credentials file %q: %w", profile, path, err)
+		}
+	} else if profile == DefaultProfile {
+		if err := json.Unmarshal(b, &c); err != nil {
+			return "", fmt.Errorf("unable to parse credentials file %q: %w", path, err)
+		}
+	}
+
+	if c.AuthToken == "" {
+		return "", fmt.Errorf("profile %q in credentials file %q has no auth_token", profile, path)
+	}
+
+	return c.AuthToken, nil
+}
@@ -0,0 +1,162 @@
+package common
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveToken(t *testing.T) {
+	dir := t.TempDir()
+
+	tokenFile := filepath.Join(dir, "token")
+	writeFile(t, tokenFile, "file-token\n")
+
+	cliCredentialsFile := filepath.Join(dir, "aiven-credentials.json")
+	writeFile(t, cliCredentialsFile, `{"auth_token": "cli-token", "user_email": "user@example.com"}`)
+
+	profilesFile := filepath.Join(dir, "profiles.json")
+	writeFile(t, profilesFile, `{"default": {"auth_token": "default-token"}, "ci": {"auth_token": "ci-token"}}`)
+
+	// the default credentials file has the profiles
+	configDir := filepath.Join(dir, "config")
+	if err := os.MkdirAll(filepath.Join(configDir, "aiven"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(
+		t,
+		filepath.Join(configDir, "aiven", "aiven-credentials.json"),
+		`{"ci": {"auth_token": "ci-token"}, "auth_token": "cli-token"}`,
+	)
+
+	tests := []struct {
+		name    string
+		opts    TokenOptions
+		want    string
+		wantErr bool
+	}{
+		{
+			"token",
+			TokenOptions{Token: "token", TokenFile: tokenFile},
+			"token",
+			false,
+		},
+		{
+			"token_file",
+			TokenOptions{TokenFile: tokenFile, TokenCommand: "echo command-token"},
+			"file-token",
+			false,
+		},
+		{
+			"missing_token_file",
+			TokenOptions{TokenFile: filepath.Join(dir, "missing")},
+			"",
+			true,
+		},
+		{
+			"cli_credentials_file",
+			TokenOptions{CredentialsFile: cliCredentialsFile},
+			"cli-token",
+			false,
+		},
+		{
+			"default_profile",
+			TokenOptions{CredentialsFile: profilesFile},
+			"default-token",
+			false,
+		},
+		{
+			"named_profile",
+			TokenOptions{CredentialsFile: profilesFile, Profile: "ci"},
+			"ci-token",
+			false,
+		},
+		{
+			"missing_profile",
+			TokenOptions{CredentialsFile: profilesFile, Profile: "prod"},
+			"",
+			true,
+		},
+		{
+			"missing_credentials_file",
+			TokenOptions{CredentialsFile: filepath.Join(dir, "missing")},
+			"",
+			true,
+		},
+		{
+			"default_credentials_file_with_profile",
+			TokenOptions{Profile: "ci"},
+			"ci-token",
+			false,
+		},
+		{
+			// the credentials file of the Aiven CLI is not read unless asked for
+			"nothing_set",
+			TokenOptions{},
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AIVEN_CREDENTIALS_FILE", "")
+			t.Setenv("XDG_CONFIG_HOME", configDir)
+
+			got, err := ResolveToken(context.Background(), tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveToken() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires a POSIX shell")
+	}
+
+	counter := filepath.Join(t.TempDir(), "counter")
+	command := "echo x >> " + counter + " && echo command-token"
+
+	cache := NewTokenCache()
+
+	for i := 0; i < 2; i++ {
+		got, err := ResolveToken(context.Background(), TokenOptions{TokenCommand: command, Cache: cache})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "command-token", got)
+	}
+
+	// The command must run only once, the second token comes from the cache.
+	b, err := os.ReadFile(counter)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "x\n", string(b))
+	}
+
+	// Another cache, i.e. another provider instance, runs the command again.
+	_, err = ResolveToken(context.Background(), TokenOptions{TokenCommand: command, Cache: NewTokenCache()})
+	assert.NoError(t, err)
+
+	b, err = os.ReadFile(counter)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "x\nx\n", string(b))
+	}
+
+	_, err = ResolveToken(context.Background(), TokenOptions{TokenCommand: "echo failure >&2 && exit 1"})
+	assert.ErrorContains(t, err, "failure")
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,192 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+const (
+	// httpLogSubsystem is the tflog subsystem the Aiven API calls are logged to.
+	httpLogSubsystem = "http"
+
+	// envLogHTTPBodies enables logging of the request and response bodies of the Aiven API calls.
+	envLogHTTPBodies = "TF_LOG_PROVIDER_AIVEN_HTTP"
+
+	// redacted replaces the secrets in the logged bodies.
+	redacted = "***"
+)
+
+var (
+	// sensitiveKeyRegexp matches the JSON keys whose values are masked in the logged bodies.
+	sensitiveKeyRegexp = regexp.MustCompile(
+		`(?i)(password|passwd|secret|token|private_key|access_key|api_key|client_key|ssl_key|access_cert|certificate|` +
+			`(^|_)cert$|(^|_)uris?$)`,
+	)
+
+	// userinfoRegexp matches the user info of URLs, e.g. the user and password of the service URIs, which is
+	// masked wherever it appears.
+	userinfoRegexp = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://)[^/?#@\s"']+@`)
+
+	// pemRegexp matches PEM encoded certificates and keys, which are masked wherever they appear.
+	pemRegexp = regexp.MustCompile(`-----BEGIN [A-Z ]+-----[\s\S]*?-----END [A-Z ]+-----`)
+)
+
+// retryAttemptKey is the context key of the retry attempt of a request.
+type retryAttemptKey struct{}
+
+// withRetryAttempt returns a context carrying the retry attempt of a request, zero being the first try.
+func withRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+// retryAttempt returns the retry attempt of a request carried by ctx.
+func retryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+
+	return attempt
+}
+
+// loggingTransport logs the requests sent to the Aiven API and their responses.
+type loggingTransport struct {
+	next http.RoundTripper
+	// logCtx holds the logger. The Aiven client does not pass the context of the Terraform RPC to the
+	// requests, so the transport has its own provider logger.
+	logCtx context.Context
+	// logBodies enables logging of the request and response bodies.
+	logBodies bool
+}
+
+// newLoggingTransport returns a loggingTransport wrapping next. Bodies are logged when envLogHTTPBodies is true.
+func newLoggingTransport(next http.RoundTripper) *loggingTransport {
+	ctx := tfsdklog.NewRootProviderLogger(
+		context.Background(),
+		tfsdklog.WithLogName("aiven"),
+		tfsdklog.WithStderrFromInit(),
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "AIVEN"),
+	)
+
+	logBodies, _ := strconv.ParseBool(os.Getenv(envLogHTTPBodies))
+
+	return &loggingTransport{
+		next:      next,
+		logCtx:    tflog.NewSubsystem(ctx, httpLogSubsystem),
+		logBodies: logBodies,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+		"retry":  retryAttempt(req.Context()),
+	}
+
+	if t.logBodies && req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			_ = body.Close()
+			fields["request_body"] = redactBody(b)
+		}
+	}
+
+	start := time.Now()
+	rsp, err := t.next.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(t.logCtx, httpLogSubsystem, "Aiven API request failed", fields)
+
+		return nil, err
+	}
+
+	fields["status"] = rsp.StatusCode
+	fields["request_id"] = rsp.Header.Get("X-Request-Id")
+
+	if t.logBodies && rsp.Body != nil {
+		b, err := io.ReadAll(rsp.Body)
+		_ = rsp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		rsp.Body = io.NopCloser(bytes.NewReader(b))
+		fields["response_body"] = redactBody(b)
+	}
+
+	tflog.SubsystemDebug(t.logCtx, httpLogSubsystem, "Aiven API request", fields)
+
+	return rsp, nil
+}
+
+// redactBody returns the body with the values of the sensitive JSON keys, the PEM blocks and the user info of URLs
+// masked.
+func redactBody(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return redactString(string(b))
+	}
+
+	out, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return ""
+	}
+
+	return string(out)
+}
+
+// redactValue masks the sensitive values of a decoded JSON value.
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if sensitiveKeyRegexp.MatchString(k) {
+				v[k] = redactSensitiveValue(item)
+			} else {
+				v[k] = redactValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	case string:
+		return redactString(v)
+	}
+
+	return v
+}
+
+// redactSensitiveValue masks the value of a sensitive key, the strings of lists such as uris are masked one by one.
+func redactSensitiveValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		return redacted
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactSensitiveValue(item)
+		}
+
+		return v
+	}
+
+	return redactValue(v)
+}
+
+// redactString masks the PEM blocks and the user info of URLs in s.
+func redactString(s string) string {
+	s = pemRegexp.ReplaceAllString(s, redacted)
+
+	return userinfoRegexp.ReplaceAllString(s, "${1}"+redacted+"@")
+}
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"sync"
+
+	"github.com/aiven/aiven-go-client"
+)
+
+// ProviderMeta is passed by a provider instance to its resources and data sources. Aliased providers each
+// have their own, so nothing cached in it is shared between them, even when they share the Aiven client.
+type ProviderMeta struct {
+	// Client is the Aiven client of the provider instance.
+	Client *aiven.Client
+	// Config is the provider level configuration.
+	Config *ProviderConfig
+	// Lookups caches the read-only Aiven API lookups of the provider instance.
+	Lookups *LookupCache
+
+	// caches holds the caches of the packages, see Cache.
+	caches sync.Map
+}
+
+// NewProviderMeta returns the provider meta of a provider instance using the client and the configuration.
+func NewProviderMeta(client *aiven.Client, config *ProviderConfig) *ProviderMeta {
+	return &ProviderMeta{
+		Client:  client,
+		Config:  config,
+		Lookups: NewLookupCache(),
+	}
+}
+
+// Cache returns the cache stored under the key, creating it with newCache on first use. The packages keep their
+// own caches, e.g. the Kafka topics, in the provider meta, using an unexported key type to avoid collisions.
+func (m *ProviderMeta) Cache(key interface{}, newCache func() interface{}) interface{} {
+	if cache, ok := m.caches.Load(key); ok {
+		return cache
+	}
+
+	cache, _ := m.caches.LoadOrStore(key, newCache())
+
+	return cache
+}
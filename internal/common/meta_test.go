@@ -0,0 +1,23 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderMetaCache(t *testing.T) {
+	type cacheKey struct{}
+
+	newCache := func() interface{} {
+		return &struct{ hits int }{}
+	}
+
+	meta := NewProviderMeta(nil, &ProviderConfig{})
+	assert.Same(t, meta.Cache(cacheKey{}, newCache), meta.Cache(cacheKey{}, newCache))
+
+	// Aliased providers have their own caches.
+	other := NewProviderMeta(nil, &ProviderConfig{})
+	assert.NotSame(t, meta.Cache(cacheKey{}, newCache), other.Cache(cacheKey{}, newCache))
+	assert.NotSame(t, meta.Lookups, other.Lookups)
+}
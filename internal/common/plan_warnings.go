@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// planWarningsKey is the context key of the warnings of a plan.
+type planWarningsKey struct{}
+
+// planWarnings collects the warnings added while planning a resource change.
+type planWarnings struct {
+	mu    sync.Mutex
+	diags []*tfprotov6.Diagnostic
+}
+
+// withPlanWarnings returns a context collecting the warnings added with AddPlanWarning.
+func withPlanWarnings(ctx context.Context) (context.Context, *planWarnings) {
+	w := &planWarnings{}
+
+	return context.WithValue(ctx, planWarningsKey{}, w), w
+}
+
+// AddPlanWarning adds a warning to the plan of the resource change planned with ctx. The SDK drops the warnings of
+// CustomizeDiff, so they are returned by the safety server wrapping the plan instead. The warning is only logged
+// when ctx does not come from a plan.
+func AddPlanWarning(ctx context.Context, summary, detail string) {
+	w, ok := ctx.Value(planWarningsKey{}).(*planWarnings)
+	if !ok {
+		log.Printf("[WARN] %s", summary)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// CustomizeDiff can run more than once for the same plan
+	for _, d := range w.diags {
+		if d.Summary == summary && d.Detail == detail {
+			return
+		}
+	}
+
+	w.diags = append(w.diags, &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// diagnostics returns the collected warnings.
+func (w *planWarnings) diagnostics() []*tfprotov6.Diagnostic {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.diags
+}
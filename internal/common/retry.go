@@ -0,0 +1,230 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultMaxRetries is the default number of times a request is retried after a retryable response or error.
+const DefaultMaxRetries = 5
+
+// retryAfterMax caps the wait time requested by the server through the Retry-After header.
+const retryAfterMax = 5 * time.Minute
+
+// retryWaitMin and retryWaitMax bound the exponential backoff between retries. These are not consts
+// so that tests can shorten them.
+var (
+	retryWaitMin = 1 * time.Second
+	retryWaitMax = 30 * time.Second
+)
+
+// retryTransport rate limits the requests sent to the Aiven API and retries the ones that
+// failed with a connection error or a status code signaling a transient error. It replaces the
+// retries the Aiven client does on its own when AIVEN_CA_CERT is set.
+type retryTransport struct {
+	next       http.RoundTripper
+	limiter    *rate.Limiter
+	maxRetries int
+}
+
+// newRetryTransport returns a retryTransport wrapping next. A maxRequestsPerSecond of zero
+// disables rate limiting, a maxRetries of zero disables retries.
+func newRetryTransport(next http.RoundTripper, maxRequestsPerSecond float64, maxRetries int) *retryTransport {
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if maxRequestsPerSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(maxRequestsPerSecond), int(math.Ceil(maxRequestsPerSecond)))
+	}
+
+	return &retryTransport{
+		next:       next,
+		limiter:    limiter,
+		maxRetries: maxRetries,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		r := req
+		if attempt > 0 {
+			r = req.Clone(withRetryAttempt(ctx, attempt))
+
+			if req.Body != nil && req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+
+				r.Body = body
+			}
+		}
+
+		rsp, err := t.next.RoundTrip(r)
+
+		// A request with a body that cannot be replayed must not be retried.
+		replayable := req.Body == nil || req.GetBody != nil
+
+		if err != nil {
+			if attempt >= t.maxRetries || !replayable || !isRetryableError(req, err) {
+				return nil, err
+			}
+
+			wait := backoff(attempt)
+
+			log.Printf(
+				"[DEBUG] %s %s failed: %s, retrying in %s (attempt %d of %d)",
+				req.Method, req.URL.Path, err, wait, attempt+1, t.maxRetries,
+			)
+
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if attempt >= t.maxRetries || !replayable || !isRetryable(req, rsp.StatusCode) {
+			return rsp, nil
+		}
+
+		wait := retryAfter(rsp)
+		if wait <= 0 {
+			wait = backoff(attempt)
+		}
+
+		log.Printf(
+			"[DEBUG] %s %s returned %d, retrying in %s (attempt %d of %d)",
+			req.Method, req.URL.Path, rsp.StatusCode, wait, attempt+1, t.maxRetries,
+		)
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, rsp.Body)
+		_ = rsp.Body.Close()
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isRetryable returns true if the request should be retried after receiving the given status code.
+// 429 and 503 mean the request was not processed and can always be retried, and so does 502, which the
+// Aiven API gateway returns before the request reaches the backend. 504 can happen after the request
+// was processed, so only idempotent requests are retried.
+func isRetryable(req *http.Request, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusBadGateway:
+		return true
+	case http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+
+	return false
+}
+
+// isRetryableError returns true if the request should be retried after failing with the given error.
+// The requests that could not connect were not sent and can always be retried, the ones that failed
+// afterwards may have been processed, so only idempotent requests are retried. Canceled requests and
+// certificate errors are never retried.
+func isRetryableError(req *http.Request, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var (
+		unknownAuthorityErr  x509.UnknownAuthorityError
+		certificateErr       x509.CertificateInvalidError
+		hostnameErr          x509.HostnameError
+		certificateVerifyErr *tls.CertificateVerificationError
+	)
+	if errors.As(err, &unknownAuthorityErr) || errors.As(err, &certificateErr) ||
+		errors.As(err, &hostnameErr) || errors.As(err, &certificateVerifyErr) {
+		return false
+	}
+
+	var (
+		opErr  *net.OpError
+		dnsErr *net.DNSError
+	)
+	if (errors.As(err, &opErr) && opErr.Op == "dial") || errors.As(err, &dnsErr) {
+		return true
+	}
+
+	return isIdempotent(req)
+}
+
+// isIdempotent returns true if the request can be sent more than once with the same effect.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryAfter returns the wait time requested by the server through the Retry-After header,
+// or zero if there is none.
+func retryAfter(rsp *http.Response) time.Duration {
+	v := rsp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(v); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(v); err == nil {
+		wait = time.Until(date)
+	}
+
+	if wait > retryAfterMax {
+		wait = retryAfterMax
+	}
+
+	return wait
+}
+
+// backoff returns the exponential backoff with jitter for the given attempt.
+func backoff(attempt int) time.Duration {
+	wait := retryWaitMax
+	if attempt < 16 {
+		if w := retryWaitMin << attempt; w < retryWaitMax {
+			wait = w
+		}
+	}
+
+	// nolint:gosec // Jitter does not need a cryptographically secure random number generator.
+	return retryWaitMin/2 + time.Duration(rand.Int63n(int64(wait)))
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
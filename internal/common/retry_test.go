@@ -0,0 +1,273 @@
+package common
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRetryTestCase(t *testing.T) {
+	waitMin, waitMax := retryWaitMin, retryWaitMax
+	retryWaitMin, retryWaitMax = time.Millisecond, 2*time.Millisecond
+
+	t.Cleanup(func() {
+		retryWaitMin, retryWaitMax = waitMin, waitMax
+	})
+}
+
+func TestRetryTransport(t *testing.T) {
+	setupRetryTestCase(t)
+
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		maxRetries   int
+		wantStatus   int
+		wantAttempts int
+	}{
+		{
+			"ok",
+			http.MethodGet,
+			[]int{200},
+			5,
+			200,
+			1,
+		},
+		{
+			"retries_429",
+			http.MethodPost,
+			[]int{429, 429, 201},
+			5,
+			201,
+			3,
+		},
+		{
+			"retries_502_on_get",
+			http.MethodGet,
+			[]int{502, 504, 503, 200},
+			5,
+			200,
+			4,
+		},
+		{
+			"retries_502_on_post",
+			http.MethodPost,
+			[]int{502, 201},
+			5,
+			201,
+			2,
+		},
+		{
+			"does_not_retry_504_on_post",
+			http.MethodPost,
+			[]int{504, 201},
+			5,
+			504,
+			1,
+		},
+		{
+			"does_not_retry_500",
+			http.MethodGet,
+			[]int{500, 200},
+			5,
+			500,
+			1,
+		},
+		{
+			"gives_up_after_max_retries",
+			http.MethodGet,
+			[]int{429, 429, 429, 200},
+			2,
+			429,
+			3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "payload", string(body))
+
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 0, tt.maxRetries)}
+
+			req, err := http.NewRequest(tt.method, server.URL, bytes.NewBufferString("payload"))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			rsp, err := client.Do(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+			_ = rsp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, rsp.StatusCode)
+			assert.Equal(t, tt.wantAttempts, attempts)
+		})
+	}
+}
+
+// roundTripperFunc implements http.RoundTripper with a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransportErrors(t *testing.T) {
+	setupRetryTestCase(t)
+
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name         string
+		method       string
+		err          error
+		wantErr      bool
+		wantAttempts int
+	}{
+		{
+			"retries_dial_error_on_post",
+			http.MethodPost,
+			dialErr,
+			false,
+			2,
+		},
+		{
+			"retries_dns_error_on_post",
+			http.MethodPost,
+			&net.DNSError{Err: "no such host", Name: "api.aiven.io", IsTemporary: true},
+			false,
+			2,
+		},
+		{
+			"retries_connection_reset_on_get",
+			http.MethodGet,
+			io.ErrUnexpectedEOF,
+			false,
+			2,
+		},
+		{
+			"does_not_retry_connection_reset_on_post",
+			http.MethodPost,
+			io.ErrUnexpectedEOF,
+			true,
+			1,
+		},
+		{
+			"does_not_retry_certificate_error",
+			http.MethodGet,
+			x509.UnknownAuthorityError{},
+			true,
+			1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+
+			next := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				attempts++
+				if attempts == 1 {
+					return nil, tt.err
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "payload", string(body))
+
+				return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(nil))}, nil
+			})
+
+			client := &http.Client{Transport: newRetryTransport(next, 0, 5)}
+
+			req, err := http.NewRequest(tt.method, "https://api.aiven.io/v1/project", bytes.NewBufferString("payload"))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			rsp, err := client.Do(req)
+			if err == nil {
+				_ = rsp.Body.Close()
+			}
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantAttempts, attempts)
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{
+			"empty",
+			"",
+			0,
+		},
+		{
+			"seconds",
+			"3",
+			3 * time.Second,
+		},
+		{
+			"capped",
+			"3600",
+			retryAfterMax,
+		},
+		{
+			"invalid",
+			"soon",
+			0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp := &http.Response{Header: http.Header{}}
+			if tt.value != "" {
+				rsp.Header.Set("Retry-After", tt.value)
+			}
+
+			assert.Equal(t, tt.want, retryAfter(rsp))
+		})
+	}
+}
+
+func TestRetryTransportRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 20, 0)}
+
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		rsp, err := client.Get(server.URL)
+		if !assert.NoError(t, err) {
+			return
+		}
+		_ = rsp.Body.Close()
+	}
+
+	// The first 20 requests are served by the initial burst, the remaining 10 need at least 0.5 seconds.
+	assert.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+}
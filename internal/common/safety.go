@@ -0,0 +1,265 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// safetyServer wraps the whole provider server, both to carry the plan warnings and to run the safety checks.
+//
+// The warnings added with AddPlanWarning while planning, e.g. by CustomizeDiff functions, are returned as
+// diagnostics of the plan, which the SDK has no other means for.
+//
+// The safety checks block the operations forbidden by the read_only and prevent_destroy_types provider
+// options, here because the SDK does not call CustomizeDiff when planning a destroy. Terraform 1.3 and later
+// plan destroys through PlanResourceChange, so these are rejected at plan time, ApplyResourceChange is
+// checked as well for older versions.
+type safetyServer struct {
+	tfprotov6.ProviderServer
+
+	// mu guards the fields below, which are set when the provider is configured.
+	mu                  sync.RWMutex
+	readOnly            bool
+	preventDestroyTypes map[string]bool
+	resourceTypes       map[string]tftypes.Type
+}
+
+// NewSafetyServer returns a provider server that returns the plan warnings and enforces the read_only and
+// prevent_destroy_types provider options on top of next.
+func NewSafetyServer(next tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	return &safetyServer{ProviderServer: next}
+}
+
+// ConfigureProvider implements tfprotov6.ProviderServer.
+func (s *safetyServer) ConfigureProvider(
+	ctx context.Context,
+	req *tfprotov6.ConfigureProviderRequest,
+) (*tfprotov6.ConfigureProviderResponse, error) {
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if err != nil || hasErrorDiagnostics(resp.Diagnostics) {
+		return resp, err
+	}
+
+	schemas, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := req.Config.Unmarshal(schemas.Provider.ValueType())
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes map[string]tftypes.Value
+	if err = config.As(&attributes); err != nil {
+		return nil, err
+	}
+
+	readOnly, _ := strconv.ParseBool(os.Getenv("AIVEN_READ_ONLY"))
+	if v := attributes["read_only"]; v.IsKnown() && !v.IsNull() {
+		if err = v.As(&readOnly); err != nil {
+			return nil, err
+		}
+	}
+
+	resourceTypes := make(map[string]tftypes.Type, len(schemas.ResourceSchemas))
+	for name, schema := range schemas.ResourceSchemas {
+		resourceTypes[name] = schema.ValueType()
+	}
+
+	preventDestroyTypes := make(map[string]bool)
+	if v := attributes["prevent_destroy_types"]; v.IsFullyKnown() && !v.IsNull() {
+		var types []tftypes.Value
+		if err = v.As(&types); err != nil {
+			return nil, err
+		}
+
+		for _, t := range types {
+			var name string
+			if err = t.As(&name); err != nil {
+				return nil, err
+			}
+
+			if _, ok := resourceTypes[name]; !ok {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Unknown resource type in prevent_destroy_types",
+					Detail:   fmt.Sprintf("The provider has no resource type %q.", name),
+				})
+			}
+
+			preventDestroyTypes[name] = true
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.readOnly = readOnly
+	s.preventDestroyTypes = preventDestroyTypes
+	s.resourceTypes = resourceTypes
+
+	return resp, nil
+}
+
+// PlanResourceChange implements tfprotov6.ProviderServer.
+func (s *safetyServer) PlanResourceChange(
+	ctx context.Context,
+	req *tfprotov6.PlanResourceChangeRequest,
+) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, warnings := withPlanWarnings(ctx)
+
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, warnings.diagnostics()...)
+	if hasErrorDiagnostics(resp.Diagnostics) {
+		return resp, nil
+	}
+
+	operation, err := s.operation(req.TypeName, req.PriorState, resp.PlannedState, len(resp.RequiresReplace) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if diag := s.check(req.TypeName, operation); diag != nil {
+		resp.Diagnostics = append(resp.Diagnostics, diag)
+	}
+
+	return resp, nil
+}
+
+// ApplyResourceChange implements tfprotov6.ProviderServer.
+func (s *safetyServer) ApplyResourceChange(
+	ctx context.Context,
+	req *tfprotov6.ApplyResourceChangeRequest,
+) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	operation, err := s.operation(req.TypeName, req.PriorState, req.PlannedState, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if diag := s.check(req.TypeName, operation); diag != nil {
+		return &tfprotov6.ApplyResourceChangeResponse{
+			NewState:    req.PriorState,
+			Diagnostics: []*tfprotov6.Diagnostic{diag},
+		}, nil
+	}
+
+	return s.ProviderServer.ApplyResourceChange(ctx, req)
+}
+
+// operation returns the operation Terraform performs on a resource going from the prior to the planned state,
+// or an empty string if there is no change.
+func (s *safetyServer) operation(
+	typeName string,
+	priorState, plannedState *tfprotov6.DynamicValue,
+	replace bool,
+) (string, error) {
+	s.mu.RLock()
+	typ, ok := s.resourceTypes[typeName]
+	s.mu.RUnlock()
+
+	// The provider is not configured yet, e.g. when validating.
+	if !ok {
+		return "", nil
+	}
+
+	prior, err := unmarshalState(priorState, typ)
+	if err != nil {
+		return "", err
+	}
+
+	planned, err := unmarshalState(plannedState, typ)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case prior.IsNull() && planned.IsNull():
+		return "", nil
+	case prior.IsNull():
+		return "create", nil
+	case planned.IsNull():
+		return "destroy", nil
+	case replace:
+		return "replace", nil
+	case !prior.Equal(planned):
+		return "update", nil
+	}
+
+	return "", nil
+}
+
+// check returns an error diagnostic if the provider options forbid the operation on the resource type.
+func (s *safetyServer) check(typeName, operation string) *tfprotov6.Diagnostic {
+	if operation == "" {
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.readOnly {
+		return &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("The provider is read only, %s of %s is not allowed", operation, typeName),
+			Detail:   "The read_only provider option forbids any change to the resources. Unset it to apply changes.",
+		}
+	}
+
+	if (operation == "destroy" || operation == "replace") && s.preventDestroyTypes[typeName] {
+		return &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("The provider prevents destroying %s, %s is not allowed", typeName, operation),
+			Detail: fmt.Sprintf(
+				"The prevent_destroy_types provider option contains %s: %s. Remove %s from it to destroy the resource.",
+				typeName, strings.Join(s.sortedPreventDestroyTypes(), ", "), typeName,
+			),
+		}
+	}
+
+	return nil
+}
+
+// sortedPreventDestroyTypes returns the resource types that cannot be destroyed, sorted by name.
+func (s *safetyServer) sortedPreventDestroyTypes() []string {
+	names := make([]string, 0, len(s.preventDestroyTypes))
+	for name := range s.preventDestroyTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// unmarshalState decodes a resource state, a missing state is null.
+func unmarshalState(state *tfprotov6.DynamicValue, typ tftypes.Type) (tftypes.Value, error) {
+	if state == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	return state.Unmarshal(typ)
+}
+
+// hasErrorDiagnostics returns true if any of the diagnostics is an error.
+func hasErrorDiagnostics(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d != nil && d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,223 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProviderServer is a provider server with a single aiven_kafka resource, which plans the proposed state.
+type fakeProviderServer struct {
+	tfprotov6.ProviderServer
+
+	requiresReplace bool
+	applied         bool
+
+	// warnings are added to the plan with AddPlanWarning.
+	warnings []string
+}
+
+var (
+	fakeProviderType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"read_only":             tftypes.Bool,
+		"prevent_destroy_types": tftypes.Set{ElementType: tftypes.String},
+	}}
+	fakeResourceType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"plan": tftypes.String,
+	}}
+)
+
+func (s *fakeProviderServer) GetProviderSchema(
+	context.Context,
+	*tfprotov6.GetProviderSchemaRequest,
+) (*tfprotov6.GetProviderSchemaResponse, error) {
+	return &tfprotov6.GetProviderSchemaResponse{
+		Provider: &tfprotov6.Schema{Block: &tfprotov6.SchemaBlock{Attributes: []*tfprotov6.SchemaAttribute{
+			{Name: "read_only", Type: tftypes.Bool, Optional: true},
+			{Name: "prevent_destroy_types", Type: tftypes.Set{ElementType: tftypes.String}, Optional: true},
+		}}},
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"aiven_kafka": {Block: &tfprotov6.SchemaBlock{Attributes: []*tfprotov6.SchemaAttribute{
+				{Name: "plan", Type: tftypes.String, Required: true},
+			}}},
+		},
+	}, nil
+}
+
+func (s *fakeProviderServer) ConfigureProvider(
+	context.Context,
+	*tfprotov6.ConfigureProviderRequest,
+) (*tfprotov6.ConfigureProviderResponse, error) {
+	return &tfprotov6.ConfigureProviderResponse{}, nil
+}
+
+func (s *fakeProviderServer) PlanResourceChange(
+	ctx context.Context,
+	req *tfprotov6.PlanResourceChangeRequest,
+) (*tfprotov6.PlanResourceChangeResponse, error) {
+	for _, w := range s.warnings {
+		AddPlanWarning(ctx, w, "")
+	}
+
+	resp := &tfprotov6.PlanResourceChangeResponse{PlannedState: req.ProposedNewState}
+	if s.requiresReplace {
+		resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("plan")}
+	}
+
+	return resp, nil
+}
+
+func (s *fakeProviderServer) ApplyResourceChange(
+	_ context.Context,
+	req *tfprotov6.ApplyResourceChangeRequest,
+) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	s.applied = true
+
+	return &tfprotov6.ApplyResourceChangeResponse{NewState: req.PlannedState}, nil
+}
+
+// fakeDynamicValue returns the value of typ encoded as a DynamicValue.
+func fakeDynamicValue(t *testing.T, typ tftypes.Type, value map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	var v tftypes.Value
+	if value == nil {
+		v = tftypes.NewValue(typ, nil)
+	} else {
+		v = tftypes.NewValue(typ, value)
+	}
+
+	dv, err := tfprotov6.NewDynamicValue(typ, v)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return &dv
+}
+
+// fakeKafka returns the state of an aiven_kafka resource with the plan, nil if plan is empty.
+func fakeKafka(t *testing.T, plan string) *tfprotov6.DynamicValue {
+	if plan == "" {
+		return fakeDynamicValue(t, fakeResourceType, nil)
+	}
+
+	return fakeDynamicValue(t, fakeResourceType, map[string]tftypes.Value{
+		"plan": tftypes.NewValue(tftypes.String, plan),
+	})
+}
+
+func TestSafetyServer(t *testing.T) {
+	preventKafka := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "aiven_kafka"),
+	})
+	noTypes := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+
+	tests := []struct {
+		name            string
+		readOnly        bool
+		preventTypes    tftypes.Value
+		prior           string
+		proposed        string
+		requiresReplace bool
+		wantError       bool
+	}{
+		{"no_options_destroy", false, noTypes, "business-4", "", false, false},
+		{"read_only_create", true, noTypes, "", "business-4", false, true},
+		{"read_only_update", true, noTypes, "business-4", "business-8", false, true},
+		{"read_only_destroy", true, noTypes, "business-4", "", false, true},
+		{"read_only_no_change", true, noTypes, "business-4", "business-4", false, false},
+		{"prevent_destroy", false, preventKafka, "business-4", "", false, true},
+		{"prevent_destroy_replace", false, preventKafka, "business-4", "business-8", true, true},
+		{"prevent_destroy_update", false, preventKafka, "business-4", "business-8", false, false},
+		{"prevent_destroy_create", false, preventKafka, "", "business-4", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AIVEN_READ_ONLY", "")
+
+			ctx := context.Background()
+			fake := &fakeProviderServer{requiresReplace: tt.requiresReplace}
+			server := NewSafetyServer(fake)
+
+			configResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+				Config: fakeDynamicValue(t, fakeProviderType, map[string]tftypes.Value{
+					"read_only":             tftypes.NewValue(tftypes.Bool, tt.readOnly),
+					"prevent_destroy_types": tt.preventTypes,
+				}),
+			})
+			if !assert.NoError(t, err) || !assert.Empty(t, configResp.Diagnostics) {
+				return
+			}
+
+			planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "aiven_kafka",
+				PriorState:       fakeKafka(t, tt.prior),
+				ProposedNewState: fakeKafka(t, tt.proposed),
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.wantError, hasErrorDiagnostics(planResp.Diagnostics))
+
+			applyResp, err := server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+				TypeName:     "aiven_kafka",
+				PriorState:   fakeKafka(t, tt.prior),
+				PlannedState: fakeKafka(t, tt.proposed),
+			})
+			if !assert.NoError(t, err) {
+				return
+			}
+			// A replacement is applied as a destroy and a create, the apply of the destroy is rejected.
+			blocked := tt.wantError && !tt.requiresReplace
+			assert.Equal(t, blocked, hasErrorDiagnostics(applyResp.Diagnostics))
+			assert.Equal(t, !blocked, fake.applied)
+		})
+	}
+}
+
+func TestSafetyServerReadOnlyFromEnv(t *testing.T) {
+	t.Setenv("AIVEN_READ_ONLY", "true")
+
+	ctx := context.Background()
+	server := NewSafetyServer(&fakeProviderServer{})
+
+	configResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: fakeDynamicValue(t, fakeProviderType, map[string]tftypes.Value{
+			"read_only":             tftypes.NewValue(tftypes.Bool, nil),
+			"prevent_destroy_types": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		}),
+	})
+	if !assert.NoError(t, err) || !assert.Empty(t, configResp.Diagnostics) {
+		return
+	}
+
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "aiven_kafka",
+		PriorState:       fakeKafka(t, ""),
+		ProposedNewState: fakeKafka(t, "business-4"),
+	})
+	if assert.NoError(t, err) {
+		assert.True(t, hasErrorDiagnostics(planResp.Diagnostics))
+	}
+}
+
+func TestSafetyServerPlanWarnings(t *testing.T) {
+	ctx := context.Background()
+	server := NewSafetyServer(&fakeProviderServer{warnings: []string{"disk_space is deprecated", "disk_space is deprecated"}})
+
+	planResp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "aiven_kafka",
+		PriorState:       fakeKafka(t, "business-4"),
+		ProposedNewState: fakeKafka(t, "business-4"),
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the warnings added more than once are returned once
+	if assert.Len(t, planResp.Diagnostics, 1) {
+		assert.Equal(t, tfprotov6.DiagnosticSeverityWarning, planResp.Diagnostics[0].Severity)
+		assert.Equal(t, "disk_space is deprecated", planResp.Diagnostics[0].Summary)
+	}
+}
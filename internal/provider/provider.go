@@ -4,19 +4,26 @@ package provider
 import (
 	"context"
 	"os"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/provider/service/organization"
 )
 
 // AivenProvider is the provider implementation for Aiven.
 type AivenProvider struct {
 	version string
+
+	// tokenCache keeps the tokens returned by api_token_command, it is shared with the SDK provider.
+	tokenCache *common.TokenCache
 }
 
 var _ provider.Provider = &AivenProvider{}
@@ -25,6 +32,45 @@ var _ provider.Provider = &AivenProvider{}
 type AivenProviderModel struct {
 	// APIToken is the Aiven API token.
 	APIToken types.String `tfsdk:"api_token"`
+	// APITokenFile is a path to a file containing the Aiven API token.
+	APITokenFile types.String `tfsdk:"api_token_file"`
+	// APITokenCommand is a command printing the Aiven API token.
+	APITokenCommand types.String `tfsdk:"api_token_command"`
+	// APITokenProfile is the name of the profile in the credentials file.
+	APITokenProfile types.String `tfsdk:"api_token_profile"`
+	// CredentialsFile is the path to the credentials file.
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+	// APIURL is the base URL of the Aiven API.
+	APIURL types.String `tfsdk:"api_url"`
+	// CABundle is a PEM encoded bundle of CA certificates to trust.
+	CABundle types.String `tfsdk:"ca_bundle"`
+	// CABundleFile is a path to a PEM encoded bundle of CA certificates to trust.
+	CABundleFile types.String `tfsdk:"ca_bundle_file"`
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify types.Bool `tfsdk:"insecure_skip_verify"`
+	// HTTPProxy is the URL of the proxy used to connect to the Aiven API.
+	HTTPProxy types.String `tfsdk:"http_proxy"`
+	// MaxRequestsPerSecond is the maximum number of requests per second sent to the Aiven API.
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
+	// MaxRetries is the maximum number of times a request is retried.
+	MaxRetries types.Int64 `tfsdk:"max_retries"`
+	// Project is the project of the resources and data sources that do not set one.
+	Project types.String `tfsdk:"project"`
+	// ReadOnly forbids any change to the resources, it is enforced by common.NewSafetyServer.
+	ReadOnly types.Bool `tfsdk:"read_only"`
+	// PreventDestroyTypes are the resource types that cannot be destroyed, it is enforced by
+	// common.NewSafetyServer.
+	PreventDestroyTypes types.Set `tfsdk:"prevent_destroy_types"`
+	// MaxMonthlyCostUSD is the maximum estimated monthly cost of the services in the configuration.
+	MaxMonthlyCostUSD types.Float64 `tfsdk:"max_monthly_cost_usd"`
+	// DefaultTags are the tags added to every taggable resource.
+	DefaultTags []defaultTagsModel `tfsdk:"default_tags"`
+}
+
+// defaultTagsModel is the default_tags block of the provider configuration.
+type defaultTagsModel struct {
+	// Tags are the tags as key-value pairs.
+	Tags types.Map `tfsdk:"tags"`
 }
 
 // Metadata returns information about the provider.
@@ -34,21 +80,117 @@ func (p *AivenProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 	resp.Version = p.version
 }
 
-// Schema returns the schema for this provider's configuration.
+// Schema returns the schema for this provider's configuration. It must be identical to the schema of the SDK
+// provider, as both are served by the same mux server.
 func (p *AivenProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
-				Description: "Aiven authentication token. " +
-					"Can also be set with the AIVEN_TOKEN environment variable.",
-				MarkdownDescription: "Aiven authentication token. " +
-					"Can also be set with the `AIVEN_TOKEN` environment variable.",
-				Required:  true,
-				Sensitive: true,
+				Description: "Aiven Authentication Token. Can also be set with the `AIVEN_TOKEN` environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_token_file": schema.StringAttribute{
+				Description: "Path to a file containing the Aiven Authentication Token. Used when `api_token` " +
+					"is not set. Can also be set with the `AIVEN_TOKEN_FILE` environment variable.",
+				Optional: true,
+			},
+			"api_token_command": schema.StringAttribute{
+				Description: "Command run with the system shell that prints the Aiven Authentication Token to its " +
+					"standard output. The command runs once per provider configuration in a Terraform run. Used when " +
+					"`api_token` and `api_token_file` are not set. Can also be set with the `AIVEN_TOKEN_COMMAND` " +
+					"environment variable.",
+				Optional: true,
+			},
+			"api_token_profile": schema.StringAttribute{
+				Description: "Name of the profile in the credentials file to read the Aiven Authentication Token " +
+					"from. Used when `api_token`, `api_token_file` and `api_token_command` are not set. " +
+					"Can also be set with the `AIVEN_PROFILE` environment variable.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path to the credentials file to read the Aiven Authentication Token from, as written by the " +
+					"Aiven CLI. Defaults to `~/.config/aiven/aiven-credentials.json` when `api_token_profile` is set. " +
+					"Can also be set with the `AIVEN_CREDENTIALS_FILE` environment variable.",
+				Optional: true,
+			},
+			"api_url": schema.StringAttribute{
+				Description: "Base URL of the Aiven API, e.g. `https://api.aiven.io`. " +
+					"Can also be set with the `AIVEN_WEB_URL` environment variable.",
+				Optional: true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust in addition to the system ones when connecting " +
+					"to the Aiven API. Can also be set with the `AIVEN_CA_BUNDLE` environment variable.",
+				Optional: true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Description: "Path to a file with PEM encoded CA certificates to trust in addition to the system ones " +
+					"when connecting to the Aiven API. Can also be set with the `AIVEN_CA_CERT` environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disables TLS certificate verification of the Aiven API. Use for testing only. " +
+					"Can also be set with the `AIVEN_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional: true,
+			},
+			"http_proxy": schema.StringAttribute{
+				Description: "URL of the proxy used to connect to the Aiven API. When not set, the standard " +
+					"`HTTPS_PROXY` and `NO_PROXY` environment variables are used. " +
+					"Can also be set with the `AIVEN_HTTP_PROXY` environment variable.",
+				Optional: true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to the Aiven API. `0` means no limit. " +
+					"Can also be set with the `AIVEN_MAX_REQUESTS_PER_SECOND` environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried with an exponential backoff when the " +
+					"Aiven API responds with 429, 502, 503 or 504, or cannot be connected to. The `Retry-After` header " +
+					"is honoured. Can also be set with the `AIVEN_MAX_RETRIES` environment variable.",
+				Optional: true,
+			},
+			"project": schema.StringAttribute{
+				Description: "Default project of the resources and data sources that do not set `project`. " +
+					"Can also be set with the `AIVEN_PROJECT_NAME` environment variable.",
+				Optional: true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Fail the plan of any create, update or destroy of a resource. " +
+					"Can also be set with the `AIVEN_READ_ONLY` environment variable.",
+				Optional: true,
+			},
+			"prevent_destroy_types": schema.SetAttribute{
+				Description: "Resource types, e.g. `aiven_kafka`, whose destroy or replacement fails the plan.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"max_monthly_cost_usd": schema.Float64Attribute{
+				Description: "Fail the plan when the summed `estimated_monthly_cost_usd` of the services in the " +
+					"configuration is over this amount. `0` means no limit. " +
+					"Can also be set with the `AIVEN_MAX_MONTHLY_COST_USD` environment variable.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				Description: "Tags added to every service, project and Kafka topic managed by the provider. " +
+					"Tags set on a resource take precedence over the default tags with the same key.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							Description: "Tags as key-value pairs.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
 			},
 		},
-		MarkdownDescription: "The Aiven provider is used to interact with the various services that Aiven offers. " +
-			"The provider needs to be configured with the proper credentials before it can be used.",
 	}
 }
 
@@ -62,47 +204,121 @@ func (p *AivenProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	if data.APIToken.IsNull() {
-		token, ok := os.LookupEnv("AIVEN_TOKEN")
-		if !ok {
-			resp.Diagnostics.AddError(
-				"Aiven API token not set",
-				"Aiven API token was not set in the provider configuration or the AIVEN_TOKEN environment variable.",
-			)
+	token, err := common.ResolveToken(ctx, common.TokenOptions{
+		Token:           stringValueOrEnv(data.APIToken, "AIVEN_TOKEN"),
+		TokenFile:       stringValueOrEnv(data.APITokenFile, "AIVEN_TOKEN_FILE"),
+		TokenCommand:    stringValueOrEnv(data.APITokenCommand, "AIVEN_TOKEN_COMMAND"),
+		Profile:         stringValueOrEnv(data.APITokenProfile, "AIVEN_PROFILE"),
+		CredentialsFile: data.CredentialsFile.ValueString(),
+		Cache:           p.tokenCache,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to resolve Aiven API token", err.Error())
 
-			return
+		return
+	}
+
+	insecureSkipVerify := data.InsecureSkipVerify.ValueBool()
+	if data.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify, _ = strconv.ParseBool(os.Getenv("AIVEN_INSECURE_SKIP_VERIFY"))
+	}
+
+	maxRequestsPerSecond := data.MaxRequestsPerSecond.ValueFloat64()
+	if data.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond, _ = strconv.ParseFloat(os.Getenv("AIVEN_MAX_REQUESTS_PER_SECOND"), 64)
+	}
+
+	maxRetries := int(data.MaxRetries.ValueInt64())
+	if data.MaxRetries.IsNull() {
+		maxRetries = common.DefaultMaxRetries
+		if v, err := strconv.Atoi(os.Getenv("AIVEN_MAX_RETRIES")); err == nil {
+			maxRetries = v
 		}
+	}
+
+	maxMonthlyCostUSD := data.MaxMonthlyCostUSD.ValueFloat64()
+	if data.MaxMonthlyCostUSD.IsNull() {
+		maxMonthlyCostUSD, _ = strconv.ParseFloat(os.Getenv("AIVEN_MAX_MONTHLY_COST_USD"), 64)
+	}
+
+	if maxRequestsPerSecond < 0 || maxRetries < 0 || maxMonthlyCostUSD < 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Aiven provider configuration",
+			"max_requests_per_second, max_retries and max_monthly_cost_usd must not be negative.",
+		)
+
+		return
+	}
 
-		data.APIToken = types.StringValue(token)
+	opts := common.ClientOptions{
+		APIURL:               stringValueOrEnv(data.APIURL, "AIVEN_WEB_URL"),
+		CABundle:             stringValueOrEnv(data.CABundle, "AIVEN_CA_BUNDLE"),
+		CABundleFile:         stringValueOrEnv(data.CABundleFile, "AIVEN_CA_CERT"),
+		InsecureSkipVerify:   insecureSkipVerify,
+		HTTPProxy:            stringValueOrEnv(data.HTTPProxy, "AIVEN_HTTP_PROXY"),
+		MaxRequestsPerSecond: maxRequestsPerSecond,
+		MaxRetries:           maxRetries,
 	}
 
-	client, err := common.NewCustomAivenClient(data.APIToken.String(), req.TerraformVersion, p.version)
+	defaultTags := make(map[string]string)
+	if len(data.DefaultTags) > 0 {
+		resp.Diagnostics.Append(data.DefaultTags[0].Tags.ElementsAs(ctx, &defaultTags, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client, err := common.NewSharedAivenClient(token, req.TerraformVersion, p.version, opts)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating Aiven client", err.Error())
 
 		return
 	}
 
-	resp.DataSourceData = client
+	meta := common.NewProviderMeta(client, &common.ProviderConfig{
+		DefaultTags:       defaultTags,
+		Project:           stringValueOrEnv(data.Project, "AIVEN_PROJECT_NAME"),
+		MaxMonthlyCostUSD: maxMonthlyCostUSD,
+	})
+
+	resp.DataSourceData = meta
 
-	resp.ResourceData = client
+	resp.ResourceData = meta
+}
+
+// stringValueOrEnv returns the value of v, or the value of the environment variable env if v is not set.
+func stringValueOrEnv(v types.String, env string) string {
+	if v.IsNull() {
+		return os.Getenv(env)
+	}
+
+	return v.ValueString()
 }
 
 // Resources returns the resources supported by this provider.
 func (p *AivenProvider) Resources(context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		organization.NewOrganizationResource,
+		organization.NewOrganizationalUnitResource,
+	}
 }
 
 // DataSources returns the data sources supported by this provider.
 func (p *AivenProvider) DataSources(context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		organization.NewOrganizationDataSource,
+		organization.NewOrganizationalUnitDataSource,
+	}
 }
 
-// New returns a new provider factory for the Aiven provider.
-func New(version string) func() provider.Provider {
+// New returns a new provider factory for the Aiven provider. The tokens returned by api_token_command are kept in
+// tokenCache, which is shared with the SDK provider of the same provider instance.
+func New(version string, tokenCache *common.TokenCache) func() provider.Provider {
 	return func() provider.Provider {
 		return &AivenProvider{
-			version: version,
+			version:    version,
+			tokenCache: tokenCache,
 		}
 	}
 }
@@ -0,0 +1,298 @@
+// Package organization implements the organization resources and data sources of the framework provider.
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+var (
+	_ resource.Resource                = &organizationResource{}
+	_ resource.ResourceWithConfigure   = &organizationResource{}
+	_ resource.ResourceWithImportState = &organizationResource{}
+)
+
+// NewOrganizationResource is a constructor for the organization resource.
+func NewOrganizationResource() resource.Resource {
+	return &organizationResource{}
+}
+
+// organizationResource is the organization resource implementation.
+type organizationResource struct {
+	// client is the instance of the Aiven client to use.
+	client *aiven.Client
+}
+
+// organizationResourceModel is the model for the organization resource.
+type organizationResourceModel struct {
+	// ID is the identifier of the organization.
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the organization.
+	Name types.String `tfsdk:"name"`
+	// TenantID is the tenant identifier of the organization.
+	TenantID types.String `tfsdk:"tenant_id"`
+	// CreateTime is the timestamp of the creation of the organization.
+	CreateTime types.String `tfsdk:"create_time"`
+	// UpdateTime is the timestamp of the last update of the organization.
+	UpdateTime types.String `tfsdk:"update_time"`
+	// Timeouts are the timeouts of the operations, they have the default timeout of the SDK based resource as well.
+	Timeouts *timeoutsModel `tfsdk:"timeouts"`
+}
+
+// Metadata returns the metadata for the organization resource.
+func (r *organizationResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema returns the schema for the organization resource.
+func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Organization resource allows the creation and management of an Aiven Organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Organization name",
+				Required:    true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Tenant ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				Description: "Time of creation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_time": schema.StringAttribute{
+				Description: "Time of last update",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+// Configure sets up the organization resource.
+func (r *organizationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, diags := clientFromProviderData(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+
+	r.client = client
+}
+
+// Create creates an organization.
+func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := plan.Timeouts.withTimeout(ctx, "create")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	account, err := client.Accounts.Create(aiven.Account{
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organization", err.Error())
+
+		return
+	}
+
+	plan.ID = types.StringValue(account.Account.OrganizationId)
+
+	if err = r.read(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Error reading organization", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read reads an organization.
+func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := state.Timeouts.withTimeout(ctx, "read")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	if err := r.read(client, &state); err != nil {
+		if aiven.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Error reading organization", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates an organization.
+func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state organizationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := plan.Timeouts.withTimeout(ctx, "update")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	id, err := schemautil.NormalizeOrganizationID(client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization", err.Error())
+
+		return
+	}
+
+	account, err := client.Accounts.Update(id, aiven.Account{
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization", err.Error())
+
+		return
+	}
+
+	plan.ID = types.StringValue(account.Account.OrganizationId)
+
+	if err = r.read(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Error reading organization", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes an organization.
+func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := state.Timeouts.withTimeout(ctx, "delete")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	id, err := schemautil.NormalizeOrganizationID(client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting organization", err.Error())
+
+		return
+	}
+
+	if err = client.Accounts.Delete(id); err != nil && !aiven.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting organization", err.Error())
+	}
+}
+
+// ImportState imports an organization by its identifier.
+func (r *organizationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read fills the model with the organization identified by its ID.
+func (r *organizationResource) read(client *aiven.Client, model *organizationResourceModel) error {
+	id, err := schemautil.NormalizeOrganizationID(client, model.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	account, err := client.Accounts.Get(id)
+	if err != nil {
+		return err
+	}
+
+	model.Name = types.StringValue(account.Account.Name)
+	model.TenantID = types.StringValue(account.Account.TenantId)
+	model.CreateTime = types.StringValue(account.Account.CreateTime.String())
+	model.UpdateTime = types.StringValue(account.Account.UpdateTime.String())
+
+	return nil
+}
+
+// clientFromProviderData returns the Aiven client of the provider meta passed to a resource or a data source.
+func clientFromProviderData(data any) (*aiven.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	meta, ok := data.(*common.ProviderMeta)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf(
+				"Expected *common.ProviderMeta, got: %T. Please report this issue to the provider developers.",
+				data,
+			),
+		)
+
+		return nil, diags
+	}
+
+	return meta.Client, diags
+}
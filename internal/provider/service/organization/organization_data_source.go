@@ -0,0 +1,148 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &organizationDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationDataSource{}
+)
+
+// NewOrganizationDataSource is a constructor for the organization data source.
+func NewOrganizationDataSource() datasource.DataSource {
+	return &organizationDataSource{}
+}
+
+// organizationDataSource is the organization data source implementation.
+type organizationDataSource struct {
+	// client is the instance of the Aiven client to use.
+	client *aiven.Client
+}
+
+// organizationDataSourceModel is the model for the organization data source.
+type organizationDataSourceModel struct {
+	// ID is the identifier of the organization.
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the organization.
+	Name types.String `tfsdk:"name"`
+	// TenantID is the tenant identifier of the organization.
+	TenantID types.String `tfsdk:"tenant_id"`
+	// CreateTime is the timestamp of the creation of the organization.
+	CreateTime types.String `tfsdk:"create_time"`
+	// UpdateTime is the timestamp of the last update of the organization.
+	UpdateTime types.String `tfsdk:"update_time"`
+}
+
+// Metadata returns the metadata for the organization data source.
+func (d *organizationDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema returns the schema for the organization data source.
+func (d *organizationDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "The Organization data source provides information about the existing Aiven Organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organization.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Organization name",
+				Required:    true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Tenant ID",
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: "Time of creation",
+				Computed:    true,
+			},
+			"update_time": schema.StringAttribute{
+				Description: "Time of last update",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure sets up the organization data source.
+func (d *organizationDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, diags := clientFromProviderData(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+
+	d.client = client
+}
+
+// Read reads the organization with the configured name.
+func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := findAccountByName(d.client, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization", err.Error())
+
+		return
+	}
+
+	if account == nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization",
+			fmt.Sprintf("organization %s not found", state.Name.ValueString()),
+		)
+
+		return
+	}
+
+	state.ID = types.StringValue(account.Id)
+	state.TenantID = types.StringValue(account.TenantId)
+	state.CreateTime = types.StringValue(account.CreateTime.String())
+	state.UpdateTime = types.StringValue(account.UpdateTime.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// findAccountByName returns the account with the given name, or nil if there is none.
+func findAccountByName(client *aiven.Client, name string) (*aiven.Account, error) {
+	r, err := client.Accounts.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range r.Accounts {
+		if r.Accounts[i].Name == name {
+			return &r.Accounts[i], nil
+		}
+	}
+
+	return nil, nil
+}
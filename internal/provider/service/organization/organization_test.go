@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
 func TestAccAivenOrganization_basic(t *testing.T) {
@@ -18,9 +19,9 @@ func TestAccAivenOrganization_basic(t *testing.T) {
 	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { acc.TestAccPreCheck(t) },
-		ProviderFactories: acc.TestAccProviderFactories,
-		CheckDestroy:      testAccCheckAivenOrganizationResourceDestroy,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAivenOrganizationResourceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccOrganizationResource(rName),
@@ -30,6 +31,16 @@ func TestAccAivenOrganization_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tenant_id", "aiven"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "aiven_organization.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccOrganizationUnitToProject(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -116,7 +127,7 @@ data "aiven_organization" "organization" {
 }
 
 func testAccCheckAivenOrganizationResourceDestroy(s *terraform.State) error {
-	c := acc.TestAccProvider.Meta().(*aiven.Client)
+	c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each organizational unit is destroyed
 	for _, rs := range s.RootModule().Resources {
@@ -0,0 +1,300 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+var (
+	_ resource.Resource                = &organizationalUnitResource{}
+	_ resource.ResourceWithConfigure   = &organizationalUnitResource{}
+	_ resource.ResourceWithImportState = &organizationalUnitResource{}
+)
+
+// NewOrganizationalUnitResource is a constructor for the organizational unit resource.
+func NewOrganizationalUnitResource() resource.Resource {
+	return &organizationalUnitResource{}
+}
+
+// organizationalUnitResource is the organizational unit resource implementation.
+type organizationalUnitResource struct {
+	// client is the instance of the Aiven client to use.
+	client *aiven.Client
+}
+
+// organizationalUnitResourceModel is the model for the organizational unit resource.
+type organizationalUnitResourceModel struct {
+	// ID is the identifier of the organizational unit.
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the organizational unit.
+	Name types.String `tfsdk:"name"`
+	// ParentID is the identifier of the parent organization or organizational unit.
+	ParentID types.String `tfsdk:"parent_id"`
+	// TenantID is the tenant identifier of the organizational unit.
+	TenantID types.String `tfsdk:"tenant_id"`
+	// CreateTime is the timestamp of the creation of the organizational unit.
+	CreateTime types.String `tfsdk:"create_time"`
+	// UpdateTime is the timestamp of the last update of the organizational unit.
+	UpdateTime types.String `tfsdk:"update_time"`
+	// Timeouts are the timeouts of the operations, they have the default timeout of the SDK based resource as well.
+	Timeouts *timeoutsModel `tfsdk:"timeouts"`
+}
+
+// Metadata returns the metadata for the organizational unit resource.
+func (r *organizationalUnitResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_organizational_unit"
+}
+
+// Schema returns the schema for the organizational unit resource.
+func (r *organizationalUnitResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "The Organizational Unit resource allows the creation and management of Aiven Organizational " +
+			"Units.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organizational unit.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Organizational Unit name",
+				Required:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Parent ID. Changing this property forces recreation of the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Tenant ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				Description: "Time of creation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_time": schema.StringAttribute{
+				Description: "Time of last update",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(),
+		},
+	}
+}
+
+// Configure sets up the organizational unit resource.
+func (r *organizationalUnitResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, diags := clientFromProviderData(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+
+	r.client = client
+}
+
+// Create creates an organizational unit.
+func (r *organizationalUnitResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan organizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := plan.Timeouts.withTimeout(ctx, "create")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	parentID, err := schemautil.NormalizeOrganizationID(client, plan.ParentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organizational unit", err.Error())
+
+		return
+	}
+
+	account, err := client.Accounts.Create(aiven.Account{
+		Name:            plan.Name.ValueString(),
+		ParentAccountId: parentID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating organizational unit", err.Error())
+
+		return
+	}
+
+	plan.ID = types.StringValue(account.Account.Id)
+
+	if err = r.read(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Error reading organizational unit", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read reads an organizational unit.
+func (r *organizationalUnitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := state.Timeouts.withTimeout(ctx, "read")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	if err := r.read(client, &state); err != nil {
+		if aiven.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Error reading organizational unit", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates an organizational unit.
+func (r *organizationalUnitResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state organizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := plan.Timeouts.withTimeout(ctx, "update")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	account, err := client.Accounts.Update(state.ID.ValueString(), aiven.Account{
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organizational unit", err.Error())
+
+		return
+	}
+
+	plan.ID = types.StringValue(account.Account.Id)
+
+	if err = r.read(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Error reading organizational unit", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes an organizational unit.
+func (r *organizationalUnitResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state organizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := state.Timeouts.withTimeout(ctx, "delete")
+	defer cancel()
+
+	client := r.client.WithContext(ctx)
+
+	if err := client.Accounts.Delete(state.ID.ValueString()); err != nil && !aiven.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting organizational unit", err.Error())
+	}
+}
+
+// ImportState imports an organizational unit by its identifier.
+func (r *organizationalUnitResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read fills the model with the organizational unit identified by its ID. The parent ID keeps the
+// format, organization or account ID, it has in the model.
+func (r *organizationalUnitResource) read(client *aiven.Client, model *organizationalUnitResourceModel) error {
+	account, err := client.Accounts.Get(model.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	parentID, err := schemautil.DetermineMixedOrganizationConstraintIDToStore(
+		client,
+		model.ParentID.ValueString(),
+		account.Account.ParentAccountId,
+	)
+	if err != nil {
+		return err
+	}
+
+	model.Name = types.StringValue(account.Account.Name)
+	model.ParentID = types.StringValue(parentID)
+	model.TenantID = types.StringValue(account.Account.TenantId)
+	model.CreateTime = types.StringValue(account.Account.CreateTime.String())
+	model.UpdateTime = types.StringValue(account.Account.UpdateTime.String())
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &organizationalUnitDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationalUnitDataSource{}
+)
+
+// NewOrganizationalUnitDataSource is a constructor for the organizational unit data source.
+func NewOrganizationalUnitDataSource() datasource.DataSource {
+	return &organizationalUnitDataSource{}
+}
+
+// organizationalUnitDataSource is the organizational unit data source implementation.
+type organizationalUnitDataSource struct {
+	// client is the instance of the Aiven client to use.
+	client *aiven.Client
+}
+
+// organizationalUnitDataSourceModel is the model for the organizational unit data source.
+type organizationalUnitDataSourceModel struct {
+	// ID is the identifier of the organizational unit.
+	ID types.String `tfsdk:"id"`
+	// Name is the name of the organizational unit.
+	Name types.String `tfsdk:"name"`
+	// ParentID is the account identifier of the parent organization or organizational unit.
+	ParentID types.String `tfsdk:"parent_id"`
+	// TenantID is the tenant identifier of the organizational unit.
+	TenantID types.String `tfsdk:"tenant_id"`
+	// CreateTime is the timestamp of the creation of the organizational unit.
+	CreateTime types.String `tfsdk:"create_time"`
+	// UpdateTime is the timestamp of the last update of the organizational unit.
+	UpdateTime types.String `tfsdk:"update_time"`
+}
+
+// Metadata returns the metadata for the organizational unit data source.
+func (d *organizationalUnitDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_organizational_unit"
+}
+
+// Schema returns the schema for the organizational unit data source.
+func (d *organizationalUnitDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "The Organizational Unit data source provides information about the existing Aiven " +
+			"Organizational Unit.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the organizational unit.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Organizational Unit name",
+				Required:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Parent ID",
+				Computed:    true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "Tenant ID",
+				Computed:    true,
+			},
+			"create_time": schema.StringAttribute{
+				Description: "Time of creation",
+				Computed:    true,
+			},
+			"update_time": schema.StringAttribute{
+				Description: "Time of last update",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure sets up the organizational unit data source.
+func (d *organizationalUnitDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, diags := clientFromProviderData(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+
+	d.client = client
+}
+
+// Read reads the organizational unit with the configured name.
+func (d *organizationalUnitDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var state organizationalUnitDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, err := findAccountByName(d.client, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organizational unit", err.Error())
+
+		return
+	}
+
+	if account == nil {
+		resp.Diagnostics.AddError(
+			"Error reading organizational unit",
+			fmt.Sprintf("organizational unit %s not found", state.Name.ValueString()),
+		)
+
+		return
+	}
+
+	state.ID = types.StringValue(account.Id)
+	state.ParentID = types.StringValue(account.ParentAccountId)
+	state.TenantID = types.StringValue(account.TenantId)
+	state.CreateTime = types.StringValue(account.CreateTime.String())
+	state.UpdateTime = types.StringValue(account.UpdateTime.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
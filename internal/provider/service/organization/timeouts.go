@@ -0,0 +1,116 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+// durationDescription describes the values of the timeouts.
+const durationDescription = "A string that can be [parsed as a duration](https://pkg.go.dev/time#ParseDuration) " +
+	"consisting of numbers and unit suffixes, such as \"30s\" or \"2h45m\". Valid time units are \"s\" (seconds), " +
+	"\"m\" (minutes), \"h\" (hours)."
+
+// timeoutsModel is the model of the timeouts block, which has the attributes of the timeouts of the SDK based
+// resources.
+type timeoutsModel struct {
+	// Create is the timeout of the creation.
+	Create types.String `tfsdk:"create"`
+	// Read is the timeout of the read.
+	Read types.String `tfsdk:"read"`
+	// Update is the timeout of the update.
+	Update types.String `tfsdk:"update"`
+	// Delete is the timeout of the deletion.
+	Delete types.String `tfsdk:"delete"`
+	// Default is the timeout of the operations that have no timeout of their own.
+	Default types.String `tfsdk:"default"`
+}
+
+// timeoutsBlock returns the timeouts block of the resources.
+func timeoutsBlock() schema.Block {
+	attribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Validators:  []validator.String{durationValidator{}},
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"create": attribute(durationDescription),
+			"read": attribute(durationDescription + " Read operations occur during any refresh or planning " +
+				"operation when refresh is enabled."),
+			"update": attribute(durationDescription),
+			"delete": attribute(durationDescription + " Setting a timeout for a Delete operation is only " +
+				"applicable if changes are saved into state before the destroy operation occurs."),
+			"default": attribute(durationDescription + " Used by the operations that have no timeout set."),
+		},
+	}
+}
+
+// withTimeout returns a context with the timeout of the operation, e.g. "create", which is its own timeout, the
+// default timeout of the block, or the default timeout of the provider resources, in this order. The values are
+// checked by durationValidator beforehand.
+func (t *timeoutsModel) withTimeout(ctx context.Context, operation string) (context.Context, context.CancelFunc) {
+	timeout := *schemautil.DefaultResourceTimeouts().Default
+
+	if t != nil {
+		operations := map[string]types.String{
+			"create": t.Create,
+			"read":   t.Read,
+			"update": t.Update,
+			"delete": t.Delete,
+		}
+
+		for _, v := range []types.String{operations[operation], t.Default} {
+			if d, err := time.ParseDuration(v.ValueString()); err == nil && !v.IsNull() && !v.IsUnknown() {
+				timeout = d
+
+				break
+			}
+		}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// durationValidator checks that a string can be parsed as a duration.
+type durationValidator struct{}
+
+var _ validator.String = durationValidator{}
+
+// Description implements validator.String.
+func (v durationValidator) Description(context.Context) string {
+	return "value must be a duration, such as \"30s\" or \"2h45m\""
+}
+
+// MarkdownDescription implements validator.String.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements validator.String.
+func (v durationValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid duration",
+			fmt.Sprintf("%q is not a duration: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
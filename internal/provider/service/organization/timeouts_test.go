@@ -0,0 +1,55 @@
+package organization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func TestTimeoutsWithTimeout(t *testing.T) {
+	tests := []struct {
+		name      string
+		timeouts  *timeoutsModel
+		operation string
+		want      time.Duration
+	}{
+		{name: "no timeouts block", operation: "create", want: *schemautil.DefaultResourceTimeouts().Default},
+		{
+			name: "operation timeout",
+			timeouts: &timeoutsModel{
+				Create:  types.StringValue("5m"),
+				Default: types.StringValue("1h"),
+			},
+			operation: "create",
+			want:      5 * time.Minute,
+		},
+		{
+			name: "default timeout",
+			timeouts: &timeoutsModel{
+				Create:  types.StringValue("5m"),
+				Default: types.StringValue("1h"),
+			},
+			operation: "delete",
+			want:      time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+
+			ctx, cancel := tt.timeouts.withTimeout(context.Background(), tt.operation)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			if assert.True(t, ok) {
+				assert.WithinDuration(t, start.Add(tt.want), deadline, time.Second)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package schemautil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// ServicePlanBackupConfig is the backup configuration of a service plan.
+type ServicePlanBackupConfig struct {
+	Interval     int    `json:"interval"`
+	MaxCount     int    `json:"max_count"`
+	RecoveryMode string `json:"recovery_mode"`
+}
+
+// ServicePlanRegion are the parameters of a service plan in a cloud.
+type ServicePlanRegion struct {
+	DiskSpaceMB     int    `json:"disk_space_mb"`
+	DiskSpaceCapMB  int    `json:"disk_space_cap_mb"`
+	DiskSpaceStepMB int    `json:"disk_space_step_mb"`
+	NodeCPUCount    int    `json:"node_cpu_count"`
+	NodeMemoryMB    int    `json:"node_memory_mb"`
+	PriceUSD        string `json:"price_usd"`
+}
+
+// ServicePlan is a plan of a service type, with its parameters in the clouds it is offered in.
+type ServicePlan struct {
+	ServicePlan  string                        `json:"service_plan"`
+	ServiceType  string                        `json:"service_type"`
+	NodeCount    int                           `json:"node_count"`
+	BackupConfig ServicePlanBackupConfig       `json:"backup_config"`
+	Regions      map[string]*ServicePlanRegion `json:"regions"`
+}
+
+// serviceTypesResponse is the response of the project service types API.
+type serviceTypesResponse struct {
+	ServiceTypes map[string]struct {
+		ServicePlans []*ServicePlan `json:"service_plans"`
+	} `json:"service_types"`
+}
+
+// ListServicePlans returns the plans of the service type available in the project, sorted by name. The service types
+// are fetched once per provider instance of m.
+func ListServicePlans(ctx context.Context, m interface{}, project, serviceType string) ([]*ServicePlan, error) {
+	rsp, err := common.CachedLookup(
+		common.GetLookupCache(m),
+		common.LookupKey("service_types", project),
+		func() (*serviceTypesResponse, error) {
+			var rsp serviceTypesResponse
+
+			err := common.DoAPIRequest(
+				ctx,
+				m.(*common.ProviderMeta).Client,
+				http.MethodGet,
+				fmt.Sprintf("/v1/project/%s/service-types", url.PathEscape(project)),
+				nil,
+				&rsp,
+			)
+
+			return &rsp, err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := rsp.ServiceTypes[serviceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown service type %s", serviceType)
+	}
+
+	plans := append([]*ServicePlan(nil), t.ServicePlans...)
+	sort.Slice(plans, func(i, j int) bool { return plans[i].ServicePlan < plans[j].ServicePlan })
+
+	return plans, nil
+}
+
+// ServicePlansInCloud returns the names of the plans offered in the cloud.
+func ServicePlansInCloud(plans []*ServicePlan, cloudName string) []string {
+	var r []string
+
+	for _, p := range plans {
+		if _, ok := p.Regions[cloudName]; ok {
+			r = append(r, p.ServicePlan)
+		}
+	}
+
+	return r
+}
+
+// servicePlanNotOfferedError returns the error of a plan of the service type that is not offered in the cloud,
+// listing the plans that are. When cloudName is empty, the plan does not exist and all the plans are listed.
+func servicePlanNotOfferedError(
+	ctx context.Context,
+	m interface{},
+	project, serviceType, plan, cloudName string,
+) error {
+	msg := fmt.Sprintf("the %s plan %s does not exist", serviceType, plan)
+	if cloudName != "" {
+		msg = fmt.Sprintf("the %s plan %s is not offered in the cloud %s", serviceType, plan, cloudName)
+	}
+
+	plans, err := ListServicePlans(ctx, m, project, serviceType)
+	if err != nil {
+		return errors.New(msg)
+	}
+
+	var names []string
+	if cloudName != "" {
+		names = ServicePlansInCloud(plans, cloudName)
+	} else {
+		for _, p := range plans {
+			names = append(names, p.ServicePlan)
+		}
+	}
+
+	if len(names) == 0 {
+		return errors.New(msg)
+	}
+
+	return fmt.Errorf("%s, the plans offered are: %s", msg, strings.Join(names, ", "))
+}
+
+// Cloud is a cloud services can run in.
+type Cloud struct {
+	CloudName           string  `json:"cloud_name"`
+	CloudDescription    string  `json:"cloud_description"`
+	GeoLatitude         float64 `json:"geo_latitude"`
+	GeoLongitude        float64 `json:"geo_longitude"`
+	GeoRegion           string  `json:"geo_region"`
+	Provider            string  `json:"provider"`
+	ProviderDescription string  `json:"provider_description"`
+}
+
+// cloudsResponse is the response of the clouds API.
+type cloudsResponse struct {
+	Clouds []*Cloud `json:"clouds"`
+}
+
+// ListClouds returns the clouds available in the project, or all the public clouds when project is empty. They are
+// fetched once per provider instance of m.
+func ListClouds(ctx context.Context, m interface{}, project string) ([]*Cloud, error) {
+	path := "/v1/clouds"
+	if project != "" {
+		path = fmt.Sprintf("/v1/project/%s/clouds", url.PathEscape(project))
+	}
+
+	return common.CachedLookup(
+		common.GetLookupCache(m),
+		common.LookupKey("clouds", project),
+		func() ([]*Cloud, error) {
+			var rsp cloudsResponse
+
+			err := common.DoAPIRequest(ctx, m.(*common.ProviderMeta).Client, http.MethodGet, path, nil, &rsp)
+
+			return rsp.Clouds, err
+		},
+	)
+}
+
+// earthRadiusKm is the mean radius of the Earth.
+const earthRadiusKm = 6371.0
+
+// geoDistanceKm returns the great-circle distance between two points, with the haversine formula.
+func geoDistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := rad(lat2 - lat1)
+	dLon := rad(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(rad(lat1))*math.Cos(rad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+// NearestCloud returns the cloud nearest to the point, nil if there are no clouds.
+func NearestCloud(clouds []*Cloud, latitude, longitude float64) *Cloud {
+	var nearest *Cloud
+	var nearestDistance float64
+
+	for _, c := range clouds {
+		d := geoDistanceKm(latitude, longitude, c.GeoLatitude, c.GeoLongitude)
+		if nearest == nil || d < nearestDistance {
+			nearest, nearestDistance = c, d
+		}
+	}
+
+	return nearest
+}
@@ -0,0 +1,151 @@
+package schemautil
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestNearestCloud(t *testing.T) {
+	clouds := []*Cloud{
+		{CloudName: "aws-eu-west-1", GeoLatitude: 53.0, GeoLongitude: -8.0},
+		{CloudName: "google-europe-north1", GeoLatitude: 60.5, GeoLongitude: 22.0},
+		{CloudName: "aws-us-east-1", GeoLatitude: 38.13, GeoLongitude: -78.45},
+		{CloudName: "google-australia-southeast1", GeoLatitude: -33.86, GeoLongitude: 151.2},
+	}
+
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      string
+	}{
+		{name: "Helsinki", latitude: 60.17, longitude: 24.94, want: "google-europe-north1"},
+		{name: "London", latitude: 51.51, longitude: -0.13, want: "aws-eu-west-1"},
+		{name: "New York", latitude: 40.71, longitude: -74.01, want: "aws-us-east-1"},
+		// across the antimeridian
+		{name: "Auckland", latitude: -36.85, longitude: 174.76, want: "google-australia-southeast1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NearestCloud(clouds, tt.latitude, tt.longitude).CloudName)
+		})
+	}
+
+	assert.Nil(t, NearestCloud(nil, 0, 0))
+}
+
+func TestServicePlansInCloud(t *testing.T) {
+	plans := []*ServicePlan{
+		{ServicePlan: "business-4", Regions: map[string]*ServicePlanRegion{"aws-eu-west-1": {}, "google-europe-north1": {}}},
+		{ServicePlan: "hobbyist", Regions: map[string]*ServicePlanRegion{"google-europe-north1": {}}},
+		{ServicePlan: "startup-4", Regions: map[string]*ServicePlanRegion{"aws-eu-west-1": {}}},
+	}
+
+	assert.Equal(t, []string{"business-4", "startup-4"}, ServicePlansInCloud(plans, "aws-eu-west-1"))
+	assert.Empty(t, ServicePlansInCloud(plans, "azure-westeurope"))
+}
+
+// newCatalogueTestMeta returns a provider meta whose client gets the pg plans business-4 and startup-4 from a test
+// server, every other request is not found.
+func newCatalogueTestMeta(t *testing.T) *common.ProviderMeta {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/project/my-project/service-types" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"service_types": {"pg": {"service_plans": [
+			{"service_plan": "startup-4", "regions": {"aws-eu-west-1": {}}},
+			{"service_plan": "business-4", "regions": {"aws-eu-west-1": {}, "google-europe-north1": {}}}
+		]}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := common.NewCustomAivenClient("token", "", "", common.ClientOptions{APIURL: server.URL})
+	require.NoError(t, err)
+
+	return common.NewProviderMeta(client, &common.ProviderConfig{})
+}
+
+func TestServicePlanNotOfferedError(t *testing.T) {
+	meta := newCatalogueTestMeta(t)
+
+	tests := []struct {
+		name        string
+		serviceType string
+		cloudName   string
+		want        string
+	}{
+		{
+			name:        "not_in_cloud",
+			serviceType: "pg",
+			cloudName:   "google-europe-north1",
+			want:        "the pg plan hobbyist is not offered in the cloud google-europe-north1, the plans offered are: business-4",
+		},
+		{
+			name:        "missing",
+			serviceType: "pg",
+			want:        "the pg plan hobbyist does not exist, the plans offered are: business-4, startup-4",
+		},
+		{
+			name:        "no_plans_in_cloud",
+			serviceType: "pg",
+			cloudName:   "azure-westeurope",
+			want:        "the pg plan hobbyist is not offered in the cloud azure-westeurope",
+		},
+		{
+			name:        "unknown_service_type",
+			serviceType: "mysql",
+			want:        "the mysql plan hobbyist does not exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := servicePlanNotOfferedError(
+				context.Background(), meta, "my-project", tt.serviceType, "hobbyist", tt.cloudName,
+			)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+func TestCustomizeDiffCheckDiskSpacePlanNotFound(t *testing.T) {
+	meta := newCatalogueTestMeta(t)
+	s := ServiceCommonSchema()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project":      "my-project",
+		"service_name": "my-pg",
+		"service_type": "pg",
+		"plan":         "hobbyist",
+	})
+
+	// a new service
+	_, err := schema.InternalMap(s).Diff(context.Background(), nil, config, CustomizeDiffCheckDiskSpace, meta, true)
+	assert.EqualError(t, err, "the pg plan hobbyist does not exist, the plans offered are: business-4, startup-4")
+
+	// an existing service on a plan no longer offered
+	state := &terraform.InstanceState{
+		ID: "my-project/my-pg",
+		Attributes: map[string]string{
+			"id":           "my-project/my-pg",
+			"project":      "my-project",
+			"service_name": "my-pg",
+			"service_type": "pg",
+			"plan":         "hobbyist",
+		},
+	}
+
+	_, err = schema.InternalMap(s).Diff(context.Background(), state, config, CustomizeDiffCheckDiskSpace, meta, true)
+	assert.NoError(t, err)
+}
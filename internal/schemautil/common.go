@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 )
 
@@ -33,6 +34,13 @@ var (
 		Description:  userconfig.Desc("Identifies the project this resource belongs to.").ForceNew().Referenced().Build(),
 	}
 
+	CommonSchemaTagsAll = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All tags of the resource, including the ones inherited from the provider `default_tags`.",
+	}
+
 	CommonSchemaServiceNameReference = &schema.Schema{
 		Type:         schema.TypeString,
 		Required:     true,
@@ -62,7 +70,13 @@ func SetTagsTerraformProperties(t map[string]string) []map[string]interface{} {
 	return tags
 }
 
-func GetTagsFromSchema(d *schema.ResourceData) map[string]string {
+// GetTagsFromSchema returns the tags of the resource merged with the provider default tags.
+func GetTagsFromSchema(d *schema.ResourceData, m interface{}) map[string]string {
+	return MergeTags(common.GetProviderConfig(m).DefaultTags, getResourceTags(d))
+}
+
+// getResourceTags returns the tags set on the resource itself.
+func getResourceTags(d ResourceStateOrResourceDiff) map[string]string {
 	tags := make(map[string]string)
 
 	for _, tag := range d.Get("tag").(*schema.Set).List() {
@@ -73,6 +87,46 @@ func GetTagsFromSchema(d *schema.ResourceData) map[string]string {
 	return tags
 }
 
+// MergeTags merges the default tags and the resource tags, the resource tags take precedence.
+func MergeTags(defaultTags, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaultTags)+len(tags))
+
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+
+	for k, v := range tags {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// SetTags stores the tags returned by the API. All of them go to tags_all, while the tags inherited from the
+// provider default tags are left out of tag unless they were set on the resource, so that they don't show up
+// as a diff.
+func SetTags(d *schema.ResourceData, m interface{}, tags map[string]string) error {
+	defaultTags := common.GetProviderConfig(m).DefaultTags
+	resourceTags := getResourceTags(d)
+
+	own := make(map[string]string)
+	for k, v := range tags {
+		if dv, ok := defaultTags[k]; ok && dv == v {
+			if rv, ok := resourceTags[k]; !ok || rv != v {
+				continue
+			}
+		}
+
+		own[k] = v
+	}
+
+	if err := d.Set("tag", SetTagsTerraformProperties(own)); err != nil {
+		return err
+	}
+
+	return d.Set("tags_all", tags)
+}
+
 // PointerValueOrDefault returns pointer's value or default
 func PointerValueOrDefault[T comparable](v *T, d T) T {
 	if v == nil {
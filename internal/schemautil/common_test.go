@@ -0,0 +1,81 @@
+package schemautil
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestMergeTags(t *testing.T) {
+	got := MergeTags(
+		map[string]string{"owner": "platform", "cost-center": "42"},
+		map[string]string{"owner": "data", "env": "dev"},
+	)
+
+	assert.Equal(t, map[string]string{"owner": "data", "cost-center": "42", "env": "dev"}, got)
+}
+
+func TestSetTags(t *testing.T) {
+	meta := common.NewProviderMeta(&aiven.Client{}, &common.ProviderConfig{
+		DefaultTags: map[string]string{"owner": "platform", "cost-center": "42"},
+	})
+
+	tests := []struct {
+		name     string
+		state    []interface{}
+		apiTags  map[string]string
+		wantTags map[string]string
+	}{
+		{
+			"default_tags_are_not_resource_tags",
+			nil,
+			map[string]string{"owner": "platform", "cost-center": "42", "env": "dev"},
+			map[string]string{"env": "dev"},
+		},
+		{
+			"overridden_default_tag",
+			nil,
+			map[string]string{"owner": "data", "cost-center": "42"},
+			map[string]string{"owner": "data"},
+		},
+		{
+			"default_tag_set_on_resource",
+			[]interface{}{map[string]interface{}{"key": "owner", "value": "platform"}},
+			map[string]string{"owner": "platform", "cost-center": "42"},
+			map[string]string{"owner": "platform"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"tag": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key":   {Type: schema.TypeString, Required: true},
+							"value": {Type: schema.TypeString, Required: true},
+						},
+					},
+				},
+				"tags_all": CommonSchemaTagsAll,
+			}, map[string]interface{}{"tag": tt.state})
+
+			if !assert.NoError(t, SetTags(d, meta, tt.apiTags)) {
+				return
+			}
+
+			assert.Equal(t, tt.wantTags, getResourceTags(d))
+
+			tagsAll := make(map[string]string)
+			for k, v := range d.Get("tags_all").(map[string]interface{}) {
+				tagsAll[k] = v.(string)
+			}
+			assert.Equal(t, tt.apiTags, tagsAll)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// rawConnectionInfoServiceTypes are the service types whose connection info the Aiven client does not decode, it is
+// fetched with getRawConnectionInfo instead.
+var rawConnectionInfoServiceTypes = map[string]bool{
+	ServiceTypeClickhouse:   true,
+	ServiceTypeM3:           true,
+	ServiceTypeM3Aggregator: true,
+}
+
+// rawConnectionInfoResponse is the part of the service API response with the connection info.
+type rawConnectionInfoResponse struct {
+	Service struct {
+		ConnectionInfo map[string]interface{} `json:"connection_info"`
+	} `json:"service"`
+}
+
+// getRawConnectionInfo returns the connection info of the service as returned by the API.
+func getRawConnectionInfo(ctx context.Context, m interface{}, projectName, serviceName string) (map[string]interface{}, error) {
+	var rsp rawConnectionInfoResponse
+
+	if err := common.DoAPIRequest(
+		ctx,
+		m.(*common.ProviderMeta).Client,
+		http.MethodGet,
+		fmt.Sprintf("/v1/project/%s/service/%s", url.PathEscape(projectName), url.PathEscape(serviceName)),
+		nil,
+		&rsp,
+	); err != nil {
+		return nil, err
+	}
+
+	return rsp.Service.ConnectionInfo, nil
+}
+
+// rawString returns the string value of the key of the raw connection info.
+func rawString(raw map[string]interface{}, key string) string {
+	s, _ := raw[key].(string)
+	return s
+}
+
+// rawStrings returns the string list value of the key of the raw connection info.
+func rawStrings(raw map[string]interface{}, key string) []string {
+	l, _ := raw[key].([]interface{})
+
+	r := make([]string, 0, len(l))
+	for _, v := range l {
+		if s, ok := v.(string); ok {
+			r = append(r, s)
+		}
+	}
+
+	return r
+}
+
+// flattenConnectionInfo returns the value of the block of the service type with the connection info of the service.
+// The raw connection info is only used by the service types of rawConnectionInfoServiceTypes.
+func flattenConnectionInfo(
+	serviceType string,
+	connectionInfo aiven.ConnectionInfo,
+	raw map[string]interface{},
+	metadata interface{},
+) map[string]interface{} {
+	props := make(map[string]interface{})
+
+	switch serviceType {
+	case "cassandra":
+		props["uris"] = connectionInfo.CassandraHosts
+	case "opensearch":
+		props["opensearch_dashboards_uri"] = connectionInfo.OpensearchDashboardsURI
+	case "elasticsearch":
+		props["kibana_uri"] = connectionInfo.KibanaURI
+	case "grafana":
+		props["uris"] = connectionInfo.GrafanaURIs
+	case "influxdb":
+		props["database_name"] = connectionInfo.InfluxDBDatabaseName
+	case "kafka":
+		props["access_cert"] = connectionInfo.KafkaAccessCert
+		props["access_key"] = connectionInfo.KafkaAccessKey
+		props["connect_uri"] = connectionInfo.KafkaConnectURI
+		props["rest_uri"] = connectionInfo.KafkaRestURI
+		props["schema_registry_uri"] = connectionInfo.SchemaRegistryURI
+	case "kafka_connect":
+	case "mysql":
+		props["uris"] = connectionInfo.MySQLURIs
+		props["standby_uris"] = connectionInfo.MySQLStandbyURIs
+		props["replica_uri"] = connectionInfo.MySQLReplicaURI
+
+		params := make([]map[string]interface{}, 0, len(connectionInfo.MySQLParams))
+		for _, p := range connectionInfo.MySQLParams {
+			v := map[string]interface{}{
+				"database_name": p.DatabaseName,
+				"host":          p.Host,
+				"password":      p.Password,
+				"sslmode":       p.SSLMode,
+				"user":          p.User,
+			}
+			if port, err := strconv.ParseInt(p.Port, 10, 32); err == nil {
+				v["port"] = int(port)
+			}
+			params = append(params, v)
+		}
+		props["params"] = params
+	case "pg":
+		if connectionInfo.PostgresURIs != nil && len(connectionInfo.PostgresURIs) > 0 {
+			props["uri"] = connectionInfo.PostgresURIs[0]
+		}
+		if connectionInfo.PostgresParams != nil && len(connectionInfo.PostgresParams) > 0 {
+			params := connectionInfo.PostgresParams[0]
+			props["dbname"] = params.DatabaseName
+			props["host"] = params.Host
+			props["password"] = params.Password
+			port, err := strconv.ParseInt(params.Port, 10, 32)
+			if err == nil {
+				props["port"] = int(port)
+			}
+			props["sslmode"] = params.SSLMode
+			props["user"] = params.User
+		}
+		props["replica_uri"] = connectionInfo.PostgresReplicaURI
+		props["max_connections"] = metadata.(map[string]interface{})["max_connections"]
+	case "clickhouse":
+		props["uris"] = rawStrings(raw, "clickhouse")
+	case "redis":
+		props["uris"] = connectionInfo.RedisURIs
+		props["slave_uris"] = connectionInfo.RedisSlaveURIs
+		props["password"] = connectionInfo.RedisPassword
+	case "flink":
+		props["host_ports"] = connectionInfo.FlinkHostPorts
+	case "kafka_mirrormaker":
+		// MirrorMaker 2 has no client endpoints, the API returns no connection info
+	case "m3db":
+		props["uris"] = rawStrings(raw, "m3db")
+		props["http_cluster_uri"] = rawString(raw, "http_cluster_uri")
+		props["http_node_uri"] = rawString(raw, "http_node_uri")
+		props["influxdb_uri"] = rawString(raw, "influxdb_uri")
+		props["prometheus_remote_read_uri"] = rawString(raw, "prometheus_remote_read_uri")
+		props["prometheus_remote_write_uri"] = rawString(raw, "prometheus_remote_write_uri")
+	case "m3aggregator":
+		props["uris"] = rawStrings(raw, "m3aggregator")
+		props["aggregator_http_uri"] = rawString(raw, "aggregator_http_uri")
+	default:
+		panic(fmt.Sprintf("Unsupported service type %v", serviceType))
+	}
+
+	return props
+}
+
+func copyConnectionInfoFromAPIResponseToTerraform(
+	d *schema.ResourceData,
+	serviceType string,
+	connectionInfo aiven.ConnectionInfo,
+	rawConnectionInfo map[string]interface{},
+	metadata interface{},
+) error {
+	props := flattenConnectionInfo(serviceType, connectionInfo, rawConnectionInfo, metadata)
+
+	return d.Set(serviceType, []map[string]interface{}{props})
+}
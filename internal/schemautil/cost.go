@@ -0,0 +1,187 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// hoursPerMonth is the number of hours of the monthly estimates, the prices of the pricing API are hourly.
+const hoursPerMonth = 730
+
+func EstimatedMonthlyCostSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+		Description: "Estimated monthly cost of the service in USD, from the hourly price of the plan in the cloud " +
+			"and the price per GB of the additional disk space, over 730 hours. Empty if the plan has no price or its " +
+			"pricing cannot be read.",
+	}
+}
+
+// monthlyCostEstimate returns the estimated monthly cost in USD of the plan in the cloud with additional disk space,
+// formatted with two decimals.
+func monthlyCostEstimate(pricing *aiven.GetServicePlanPricingResponse, additionalDiskSpaceMB int) (string, error) {
+	hourly, err := strconv.ParseFloat(pricing.BasePriceUSD, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid base price %q: %w", pricing.BasePriceUSD, err)
+	}
+
+	if additionalDiskSpaceMB > 0 {
+		perGB, err := strconv.ParseFloat(pricing.ExtraDiskPricePerGBUSD, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid extra disk price %q: %w", pricing.ExtraDiskPricePerGBUSD, err)
+		}
+
+		hourly += perGB * float64(additionalDiskSpaceMB) / 1024
+	}
+
+	return strconv.FormatFloat(hourly*hoursPerMonth, 'f', 2, 64), nil
+}
+
+// getMonthlyCostEstimate returns the estimated monthly cost in USD of the service, empty if the plan has no price.
+func getMonthlyCostEstimate(
+	m interface{},
+	project, serviceType, plan, cloudName string,
+	additionalDiskSpaceMB int,
+) (string, error) {
+	pricing, err := GetServicePlanPricing(m, project, serviceType, plan, cloudName)
+	if err != nil {
+		if aiven.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("unable to get service plan pricing: %w", err)
+	}
+
+	return monthlyCostEstimate(pricing, additionalDiskSpaceMB)
+}
+
+// plannedAdditionalDiskSpaceMB returns the additional disk space of the configuration, disk_space is converted to the
+// disk space over the plan default.
+func plannedAdditionalDiskSpaceMB(ctx context.Context, d *schema.ResourceDiff, m interface{}) (int, error) {
+	if ds, ok := d.GetOk("disk_space"); ok {
+		params, err := GetServicePlanParametersFromSchema(ctx, m, d)
+		if err != nil {
+			if aiven.IsNotFound(err) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("unable to get service plan parameters: %w", err)
+		}
+
+		return ConvertToDiskSpaceMB(ds.(string)) - params.DiskSizeMBDefault, nil
+	}
+
+	if ads, ok := d.GetOk("additional_disk_space"); ok {
+		return ConvertToDiskSpaceMB(ads.(string)), nil
+	}
+
+	return 0, nil
+}
+
+// costBudget is the estimated monthly cost of the services planned by a provider instance, by service ID.
+type costBudget struct {
+	sync.Mutex
+	estimates map[string]float64
+}
+
+// costBudgetKey is the key of the cost budget in the provider meta.
+type costBudgetKey struct{}
+
+// getCostBudget returns the cost budget of the provider instance of m.
+func getCostBudget(m interface{}) *costBudget {
+	return m.(*common.ProviderMeta).Cache(costBudgetKey{}, func() interface{} {
+		return &costBudget{estimates: make(map[string]float64)}
+	}).(*costBudget)
+}
+
+// add records the estimate of the service, replacing its previous one, and returns the total of the estimates.
+func (b *costBudget) add(id string, estimate float64) float64 {
+	b.Lock()
+	defer b.Unlock()
+
+	b.estimates[id] = estimate
+
+	var total float64
+	for _, v := range b.estimates {
+		total += v
+	}
+
+	return total
+}
+
+// CustomizeDiffEstimatedMonthlyCost plans estimated_monthly_cost_usd and, when max_monthly_cost_usd is set, fails
+// once the estimates of the services planned so far are over it. Every service of the configuration is planned by
+// the same provider instance, so the last service planned sees the total of the configuration.
+func CustomizeDiffEstimatedMonthlyCost(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{"project", "plan", "cloud_name", "disk_space", "additional_disk_space"}
+	for _, k := range keys {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("estimated_monthly_cost_usd")
+		}
+	}
+
+	estimate := d.Get("estimated_monthly_cost_usd").(string)
+	limit := common.GetProviderConfig(m).MaxMonthlyCostUSD
+
+	if d.Id() == "" || estimate == "" || d.HasChanges(keys...) {
+		additionalDiskSpaceMB, err := plannedAdditionalDiskSpaceMB(ctx, d, m)
+		if err != nil {
+			return err
+		}
+
+		planned, err := getMonthlyCostEstimate(
+			m,
+			d.Get("project").(string),
+			d.Get("service_type").(string),
+			d.Get("plan").(string),
+			d.Get("cloud_name").(string),
+			additionalDiskSpaceMB,
+		)
+		if err != nil {
+			// the estimate is only needed to enforce max_monthly_cost_usd
+			if limit != 0 {
+				return err
+			}
+
+			log.Printf("[WARN] unable to estimate the monthly cost: %s", err)
+			planned = ""
+		}
+
+		if planned != estimate {
+			if err := d.SetNew("estimated_monthly_cost_usd", planned); err != nil {
+				return err
+			}
+		}
+
+		estimate = planned
+	}
+
+	if limit == 0 || estimate == "" {
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(estimate, 64)
+	if err != nil {
+		return fmt.Errorf("invalid estimated_monthly_cost_usd %q: %w", estimate, err)
+	}
+
+	id := BuildResourceID(d.Get("project").(string), d.Get("service_name").(string))
+
+	if total := getCostBudget(m).add(id, v); total > limit {
+		return fmt.Errorf(
+			"the estimated monthly cost of the services in the configuration is at least %.2f USD, "+
+				"over max_monthly_cost_usd of %.2f USD",
+			total,
+			limit,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package schemautil
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestMonthlyCostEstimate(t *testing.T) {
+	pricing := &aiven.GetServicePlanPricingResponse{BasePriceUSD: "0.274", ExtraDiskPricePerGBUSD: "0.000136986"}
+
+	tests := []struct {
+		name                  string
+		additionalDiskSpaceMB int
+		want                  string
+	}{
+		{name: "plan only", want: "200.02"},
+		{name: "additional disk space", additionalDiskSpaceMB: 100 * 1024, want: "210.02"},
+		// the disk space of the service is under the plan default while it is resized
+		{name: "negative additional disk space", additionalDiskSpaceMB: -1024, want: "200.02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := monthlyCostEstimate(pricing, tt.additionalDiskSpaceMB)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := monthlyCostEstimate(&aiven.GetServicePlanPricingResponse{BasePriceUSD: "0.1"}, 1024)
+	assert.Error(t, err, "no extra disk price")
+}
+
+func TestCostBudget(t *testing.T) {
+	b := &costBudget{estimates: make(map[string]float64)}
+
+	assert.Equal(t, 100.0, b.add("project/pg", 100))
+	assert.Equal(t, 150.0, b.add("project/kafka", 50))
+	// a service planned again replaces its estimate
+	assert.Equal(t, 170.0, b.add("project/pg", 120))
+}
+
+func TestResourceServiceReadWithoutPricingAccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/project/my-project/service/my-pg":
+			_, _ = w.Write([]byte(`{"service": {"service_name": "my-pg", "service_type": "pg", "plan": "startup-4", ` +
+				`"cloud_name": "google-europe-west1", "state": "RUNNING"}}`))
+		case "/v1/project/my-project/service-types/pg/plans/startup-4":
+			_, _ = w.Write([]byte(`{"disk_space_mb": 81920}`))
+		case "/v1/project/my-project/pricing/service-types/pg/plans/startup-4/clouds/google-europe-west1":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Not allowed"}`))
+		case "/v1/project/my-project/static-ips":
+			_, _ = w.Write([]byte(`{"static_ips": []}`))
+		case "/v1/project/my-project/service/my-pg/tags":
+			_, _ = w.Write([]byte(`{"tags": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := common.NewCustomAivenClient("token", "", "", common.ClientOptions{APIURL: server.URL})
+	require.NoError(t, err)
+
+	meta := common.NewProviderMeta(client, &common.ProviderConfig{})
+
+	d := schema.TestResourceDataRaw(t, aivenServiceTestSchema(), map[string]interface{}{
+		"project":      "my-project",
+		"service_name": "my-pg",
+		"service_type": "pg",
+	})
+	d.SetId("my-project/my-pg")
+
+	// the estimate is left empty instead of failing the read
+	assert.False(t, ResourceServiceRead(context.Background(), d, meta).HasError())
+	assert.Equal(t, "", d.Get("estimated_monthly_cost_usd"))
+	assert.Equal(t, "RUNNING", d.Get("state"))
+}
@@ -8,8 +8,12 @@ import (
 	"github.com/aiven/aiven-go-client"
 	"github.com/docker/go-units"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
 func ServiceIntegrationShouldNotBeEmpty(_ context.Context, _, new, _ interface{}) bool {
@@ -45,17 +49,41 @@ func CustomizeDiffCheckUniqueTag(_ context.Context, d *schema.ResourceDiff, _ in
 	return nil
 }
 
-func CustomizeDiffCheckDiskSpace(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
-	client := m.(*aiven.Client)
+// CustomizeDiffTagsAll plans tags_all as the resource tags merged with the provider default tags.
+func CustomizeDiffTagsAll(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("tag") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	merged := MergeTags(common.GetProviderConfig(m).DefaultTags, getResourceTags(d))
+
+	current := make(map[string]string)
+	for k, v := range d.Get("tags_all").(map[string]interface{}) {
+		current[k] = v.(string)
+	}
+
+	if maps.Equal(current, merged) {
+		return nil
+	}
 
+	return d.SetNew("tags_all", merged)
+}
+
+func CustomizeDiffCheckDiskSpace(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
 	if d.Get("service_type").(string) == "" {
 		return fmt.Errorf("cannot check dynamic disk space because service_type is empty")
 	}
 
-	servicePlanParams, err := GetServicePlanParametersFromSchema(ctx, client, d)
+	servicePlanParams, err := GetServicePlanParametersFromSchema(ctx, m, d)
 	if err != nil {
 		if aiven.IsNotFound(err) {
-			return nil
+			// the plan may not be known yet, and the existing services may be on plans no longer offered
+			if !d.NewValueKnown("project") || !d.NewValueKnown("plan") || (d.Id() != "" && !d.HasChange("plan")) {
+				return nil
+			}
+			return servicePlanNotOfferedError(
+				ctx, m, d.Get("project").(string), d.Get("service_type").(string), d.Get("plan").(string), "",
+			)
 		}
 		return fmt.Errorf("unable to get service plan parameters: %w", err)
 	}
@@ -81,7 +109,7 @@ func CustomizeDiffCheckDiskSpace(ctx context.Context, d *schema.ResourceDiff, m
 		}
 
 		// next check if the cloud allows it by checking the pricing per gb
-		if ok, err := dynamicDiskSpaceIsAllowedByPricing(ctx, client, d); err != nil {
+		if ok, err := dynamicDiskSpaceIsAllowedByPricing(ctx, m, d); err != nil {
 			return fmt.Errorf("unable to check if dynamic disk space is allowed for this service: %w", err)
 		} else if !ok {
 			return fmt.Errorf("dynamic disk space is not configurable for this service")
@@ -109,6 +137,44 @@ func CustomizeDiffCheckDiskSpace(ctx context.Context, d *schema.ResourceDiff, m
 	return nil
 }
 
+// CustomizeDiffGenericService returns the CustomizeDiff functions shared by all service resources.
+func CustomizeDiffGenericService(serviceType string) schema.CustomizeDiffFunc {
+	setServiceType := SetServiceTypeIfEmpty(serviceType)
+
+	// the generic service has its service type set in the configuration and a raw JSON user config to check instead
+	if serviceType == "service" {
+		setServiceType = CustomizeDiffGenericServiceUserConfig
+	}
+
+	return customdiff.Sequence(
+		setServiceType,
+		CustomizeDiffDisallowMultipleManyToOneKeys,
+		customdiff.IfValueChange("tag",
+			TagsShouldNotBeEmpty,
+			CustomizeDiffCheckUniqueTag,
+		),
+		CustomizeDiffTagsAll,
+		customdiff.IfValueChange("disk_space",
+			DiskSpaceShouldNotBeEmpty,
+			CustomizeDiffCheckDiskSpace,
+		),
+		customdiff.IfValueChange("additional_disk_space",
+			DiskSpaceShouldNotBeEmpty,
+			CustomizeDiffCheckDiskSpace,
+		),
+		CustomizeDiffDiskSpaceDeprecation,
+		customdiff.Sequence(
+			CustomizeDiffCheckStaticIPDisassociation,
+			CustomizeDiffCheckPlanAndStaticIpsCannotBeModifiedTogether,
+		),
+		CustomizeDiffReadReplica,
+		CustomizeDiffRestoreFrom,
+		CustomizeDiffMaintenanceUpdates,
+		CustomizeDiffServiceVersionUpgrade,
+		CustomizeDiffEstimatedMonthlyCost,
+	)
+}
+
 func SetServiceTypeIfEmpty(t string) schema.CustomizeDiffFunc {
 	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 		return diff.SetNew("service_type", t)
@@ -140,7 +206,7 @@ func CustomizeDiffCheckStaticIPDisassociation(_ context.Context, d *schema.Resou
 		return nil
 	}
 
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
 	var plannedStaticIps []string
@@ -0,0 +1,145 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/docker/go-units"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// additionalDiskSpace returns the additional_disk_space equivalent to the disk space, empty if it is not over the
+// default disk space of the plan.
+func additionalDiskSpace(diskSpaceMB, defaultDiskSpaceMB int) string {
+	if diskSpaceMB <= defaultDiskSpaceMB {
+		return ""
+	}
+
+	return HumanReadableByteSize((diskSpaceMB - defaultDiskSpaceMB) * units.MiB)
+}
+
+// diskSpaceReplacement returns how to replace the deprecated disk_space in the configuration.
+func diskSpaceReplacement(diskSpace string, defaultDiskSpaceMB int) string {
+	ads := additionalDiskSpace(ConvertToDiskSpaceMB(diskSpace), defaultDiskSpaceMB)
+	if ads == "" {
+		return fmt.Sprintf("disk_space is deprecated, remove disk_space = %q, it is the default disk space of the plan", diskSpace)
+	}
+
+	return fmt.Sprintf("disk_space is deprecated, replace disk_space = %q with additional_disk_space = %q", diskSpace, ads)
+}
+
+// diskSpaceWarning returns how to replace disk_space when the configuration sets it, and an empty string otherwise or
+// when the plan parameters cannot be fetched. The configuration is read, since the diff of disk_space is suppressed
+// when it is equivalent to additional_disk_space of the state.
+func diskSpaceWarning(ctx context.Context, d *schema.ResourceDiff, m interface{}) string {
+	c := d.GetRawConfig()
+	if c.IsNull() {
+		return ""
+	}
+
+	v := c.GetAttr("disk_space")
+	if !v.IsKnown() || v.IsNull() || v.AsString() == "" {
+		return ""
+	}
+
+	params, err := GetServicePlanParametersFromSchema(ctx, m, d)
+	if err != nil {
+		log.Printf("[DEBUG] unable to get service plan parameters: %s", err)
+		return ""
+	}
+
+	return diskSpaceReplacement(v.AsString(), params.DiskSizeMBDefault)
+}
+
+// CustomizeDiffDiskSpaceDeprecation warns in the plan how to replace disk_space with the equivalent
+// additional_disk_space.
+func CustomizeDiffDiskSpaceDeprecation(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("disk_space") || !d.NewValueKnown("plan") {
+		return nil
+	}
+
+	if w := diskSpaceWarning(ctx, d, m); w != "" {
+		common.AddPlanWarning(ctx, w, "")
+	}
+
+	return nil
+}
+
+// totalDiskSpaceMB returns the total disk space of disk_space or additional_disk_space over the default disk space
+// of the plan.
+func totalDiskSpaceMB(diskSpace, additionalDiskSpace string, defaultDiskSpaceMB int) int {
+	if diskSpace != "" {
+		return ConvertToDiskSpaceMB(diskSpace)
+	}
+
+	return defaultDiskSpaceMB + ConvertToDiskSpaceMB(additionalDiskSpace)
+}
+
+// DiffSuppressEquivalentDiskSpace suppresses the diff of disk_space and additional_disk_space when the total disk
+// space does not change, e.g. after the state upgrade replaced disk_space with additional_disk_space while the
+// configuration still sets disk_space.
+func DiffSuppressEquivalentDiskSpace(_, _, _ string, d *schema.ResourceData) bool {
+	dsd, ok := d.GetOk("disk_space_default")
+	if d.Id() == "" || !ok || d.HasChange("plan") {
+		return false
+	}
+
+	if c := d.GetRawConfig(); !c.IsNull() &&
+		(!c.GetAttr("disk_space").IsKnown() || !c.GetAttr("additional_disk_space").IsKnown()) {
+		return false
+	}
+
+	defaultDiskSpaceMB := ConvertToDiskSpaceMB(dsd.(string))
+	oldDiskSpace, newDiskSpace := d.GetChange("disk_space")
+	oldAdditional, newAdditional := d.GetChange("additional_disk_space")
+
+	return totalDiskSpaceMB(oldDiskSpace.(string), oldAdditional.(string), defaultDiskSpaceMB) ==
+		totalDiskSpaceMB(newDiskSpace.(string), newAdditional.(string), defaultDiskSpaceMB)
+}
+
+// DiskSpaceStateUpgrade replaces disk_space in the state with the equivalent additional_disk_space. The default disk
+// space of the plan is disk_space_default of the state, or fetched when the state has none. The state is left as is
+// when the default is not available, disk_space keeps working until it is removed. The configurations still setting
+// disk_space have no diff, see DiffSuppressEquivalentDiskSpace.
+func DiskSpaceStateUpgrade(
+	_ context.Context,
+	rawState map[string]interface{},
+	m interface{},
+) (map[string]interface{}, error) {
+	ds, ok := rawState["disk_space"].(string)
+	if !ok || ds == "" {
+		return rawState, nil
+	}
+
+	var defaultDiskSpaceMB int
+	if dsd, ok := rawState["disk_space_default"].(string); ok && dsd != "" {
+		defaultDiskSpaceMB = ConvertToDiskSpaceMB(dsd)
+	} else {
+		if _, ok := m.(*common.ProviderMeta); !ok {
+			return rawState, nil
+		}
+
+		project, _ := rawState["project"].(string)
+		serviceType, _ := rawState["service_type"].(string)
+		plan, _ := rawState["plan"].(string)
+
+		p, err := GetServicePlan(m, project, serviceType, plan)
+		if err != nil {
+			log.Printf("[WARN] unable to get service plan %s to migrate disk_space: %s", plan, err)
+			return rawState, nil
+		}
+
+		defaultDiskSpaceMB = p.DiskSpaceMB
+	}
+
+	rawState["disk_space"] = nil
+	rawState["additional_disk_space"] = nil
+	if ads := additionalDiskSpace(ConvertToDiskSpaceMB(ds), defaultDiskSpaceMB); ads != "" {
+		rawState["additional_disk_space"] = ads
+	}
+
+	return rawState, nil
+}
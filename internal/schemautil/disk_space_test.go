@@ -0,0 +1,151 @@
+package schemautil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskSpaceReplacement(t *testing.T) {
+	tests := []struct {
+		name      string
+		diskSpace string
+		want      string
+	}{
+		{
+			name:      "over the default",
+			diskSpace: "90GiB",
+			want:      `disk_space is deprecated, replace disk_space = "90GiB" with additional_disk_space = "10GiB"`,
+		},
+		{
+			name:      "default",
+			diskSpace: "80GiB",
+			want:      `disk_space is deprecated, remove disk_space = "80GiB", it is the default disk space of the plan`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diskSpaceReplacement(tt.diskSpace, 80*1024))
+		})
+	}
+}
+
+func TestDiskSpaceStateUpgrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawState map[string]interface{}
+		want     map[string]interface{}
+	}{
+		{
+			name: "over the default",
+			rawState: map[string]interface{}{
+				"disk_space":         "90GiB",
+				"disk_space_default": "80GiB",
+			},
+			want: map[string]interface{}{
+				"disk_space":            nil,
+				"disk_space_default":    "80GiB",
+				"additional_disk_space": "10GiB",
+			},
+		},
+		{
+			name: "default",
+			rawState: map[string]interface{}{
+				"disk_space":         "80GiB",
+				"disk_space_default": "80GiB",
+			},
+			want: map[string]interface{}{
+				"disk_space":            nil,
+				"disk_space_default":    "80GiB",
+				"additional_disk_space": nil,
+			},
+		},
+		{
+			name: "no disk_space",
+			rawState: map[string]interface{}{
+				"disk_space":            "",
+				"disk_space_default":    "80GiB",
+				"additional_disk_space": "10GiB",
+			},
+			want: map[string]interface{}{
+				"disk_space":            "",
+				"disk_space_default":    "80GiB",
+				"additional_disk_space": "10GiB",
+			},
+		},
+		{
+			// the default cannot be fetched without a configured provider
+			name: "no disk_space_default",
+			rawState: map[string]interface{}{
+				"disk_space": "90GiB",
+			},
+			want: map[string]interface{}{
+				"disk_space": "90GiB",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiskSpaceStateUpgrade(context.Background(), tt.rawState, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiffSuppressEquivalentDiskSpace(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "my-project/my-pg",
+		Attributes: map[string]string{
+			"id":                    "my-project/my-pg",
+			"project":               "my-project",
+			"service_name":          "my-pg",
+			"plan":                  "startup-4",
+			"disk_space_default":    "80GiB",
+			"additional_disk_space": "10GiB",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		diskSpace string
+		wantDiff  bool
+	}{
+		{name: "same total disk space", diskSpace: "90GiB", wantDiff: false},
+		{name: "more disk space", diskSpace: "100GiB", wantDiff: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &schema.Resource{Schema: ServiceCommonSchema()}
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"project":      "my-project",
+				"service_name": "my-pg",
+				"plan":         "startup-4",
+				"disk_space":   tt.diskSpace,
+			}), nil)
+			require.NoError(t, err)
+
+			var attributes []string
+			if diff != nil {
+				for k := range diff.Attributes {
+					attributes = append(attributes, k)
+				}
+			}
+
+			if tt.wantDiff {
+				assert.Contains(t, attributes, "disk_space")
+				assert.Contains(t, attributes, "additional_disk_space")
+			} else {
+				assert.NotContains(t, attributes, "disk_space")
+				assert.NotContains(t, attributes, "additional_disk_space")
+			}
+		})
+	}
+}
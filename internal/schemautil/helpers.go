@@ -78,20 +78,20 @@ func ConvertToDiskSpaceMB(b string) int {
 	return int(diskSizeMB / units.MiB)
 }
 
-func GetServicePlanParametersFromServiceResponse(ctx context.Context, client *aiven.Client, project string, service *aiven.Service) (PlanParameters, error) {
-	return getServicePlanParametersInternal(ctx, client, project, service.Type, service.Plan)
+func GetServicePlanParametersFromServiceResponse(ctx context.Context, m interface{}, project string, service *aiven.Service) (PlanParameters, error) {
+	return getServicePlanParametersInternal(ctx, m, project, service.Type, service.Plan)
 }
 
-func GetServicePlanParametersFromSchema(ctx context.Context, client *aiven.Client, d ResourceStateOrResourceDiff) (PlanParameters, error) {
+func GetServicePlanParametersFromSchema(ctx context.Context, m interface{}, d ResourceStateOrResourceDiff) (PlanParameters, error) {
 	project := d.Get("project").(string)
 	serviceType := d.Get("service_type").(string)
 	servicePlan := d.Get("plan").(string)
 
-	return getServicePlanParametersInternal(ctx, client, project, serviceType, servicePlan)
+	return getServicePlanParametersInternal(ctx, m, project, serviceType, servicePlan)
 }
 
-func getServicePlanParametersInternal(_ context.Context, client *aiven.Client, project, serviceType, servicePlan string) (PlanParameters, error) {
-	servicePlanResponse, err := client.ServiceTypes.GetPlan(project, serviceType, servicePlan)
+func getServicePlanParametersInternal(_ context.Context, m interface{}, project, serviceType, servicePlan string) (PlanParameters, error) {
+	servicePlanResponse, err := GetServicePlan(m, project, serviceType, servicePlan)
 	if err != nil {
 		return PlanParameters{}, err
 	}
@@ -102,7 +102,7 @@ func getServicePlanParametersInternal(_ context.Context, client *aiven.Client, p
 	}, nil
 }
 
-func dynamicDiskSpaceIsAllowedByPricing(_ context.Context, client *aiven.Client, d ResourceStateOrResourceDiff) (bool, error) {
+func dynamicDiskSpaceIsAllowedByPricing(ctx context.Context, m interface{}, d ResourceStateOrResourceDiff) (bool, error) {
 	// to check if dynamic disk space is allowed, we currently have to check
 	// the pricing api to see if the `extra_disk_price_per_gb_usd` field is set
 
@@ -111,8 +111,11 @@ func dynamicDiskSpaceIsAllowedByPricing(_ context.Context, client *aiven.Client,
 	servicePlan := d.Get("plan").(string)
 	cloudName := d.Get("cloud_name").(string)
 
-	servicePlanPricingResponse, err := client.ServiceTypes.GetPlanPricing(project, serviceType, servicePlan, cloudName)
+	servicePlanPricingResponse, err := GetServicePlanPricing(m, project, serviceType, servicePlan, cloudName)
 	if err != nil {
+		if aiven.IsNotFound(err) && cloudName != "" {
+			return false, servicePlanNotOfferedError(ctx, m, project, serviceType, servicePlan, cloudName)
+		}
 		return false, fmt.Errorf("unable to get service plan pricing from api: %w", err)
 	}
 	return len(servicePlanPricingResponse.ExtraDiskPricePerGBUSD) > 0, nil
@@ -0,0 +1,56 @@
+package schemautil
+
+import (
+	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// GetServicePlan returns the service plan, it is fetched once per provider instance of m.
+func GetServicePlan(m interface{}, project, serviceType, plan string) (*aiven.GetServicePlanResponse, error) {
+	return common.CachedLookup(
+		common.GetLookupCache(m),
+		common.LookupKey("service_plan", project, serviceType, plan),
+		func() (*aiven.GetServicePlanResponse, error) {
+			return m.(*common.ProviderMeta).Client.ServiceTypes.GetPlan(project, serviceType, plan)
+		},
+	)
+}
+
+// GetServicePlanPricing returns the pricing of the service plan in the cloud, it is fetched once per
+// provider instance of m.
+func GetServicePlanPricing(
+	m interface{},
+	project, serviceType, plan, cloudName string,
+) (*aiven.GetServicePlanPricingResponse, error) {
+	return common.CachedLookup(
+		common.GetLookupCache(m),
+		common.LookupKey("service_plan_pricing", project, serviceType, plan, cloudName),
+		func() (*aiven.GetServicePlanPricingResponse, error) {
+			return m.(*common.ProviderMeta).Client.ServiceTypes.GetPlanPricing(project, serviceType, plan, cloudName)
+		},
+	)
+}
+
+// ListServices returns the services of the project. The list is fetched once per provider instance of m and
+// until InvalidateServiceList is called, callers looking for a service that may have been created since
+// must invalidate the list first.
+func ListServices(m interface{}, project string) ([]*aiven.Service, error) {
+	return common.CachedLookup(
+		common.GetLookupCache(m),
+		serviceListKey(project),
+		func() ([]*aiven.Service, error) {
+			return m.(*common.ProviderMeta).Client.Services.List(project)
+		},
+	)
+}
+
+// InvalidateServiceList removes the cached services of the project.
+func InvalidateServiceList(m interface{}, project string) {
+	common.GetLookupCache(m).Invalidate(serviceListKey(project))
+}
+
+// serviceListKey returns the lookup cache key of the services of the project.
+func serviceListKey(project string) string {
+	return common.LookupKey("services", project)
+}
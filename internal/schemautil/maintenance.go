@@ -0,0 +1,179 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// PendingMaintenanceUpdatesSchema returns the schema of the pending_maintenance_updates attribute.
+func PendingMaintenanceUpdatesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Maintenance updates waiting to be applied to the service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Description of the update",
+				},
+				"deadline": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Deadline for applying the update, after which it is applied automatically",
+				},
+				"start_after": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The update is applied in the first maintenance window after this time",
+				},
+			},
+		},
+	}
+}
+
+// ApplyMaintenanceUpdatesSchema returns the schema of the apply_maintenance_updates attribute.
+func ApplyMaintenanceUpdatesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Starts the maintenance of the service during apply when it has pending maintenance updates, " +
+			"and waits until they are applied, instead of waiting for the maintenance window.",
+	}
+}
+
+// FlattenMaintenanceUpdates returns the pending_maintenance_updates value of the updates.
+func FlattenMaintenanceUpdates(updates []*aiven.MaintenanceUpdate) []map[string]interface{} {
+	r := make([]map[string]interface{}, 0, len(updates))
+
+	for _, u := range updates {
+		var deadline string
+		if u.Deadline != nil {
+			deadline = *u.Deadline
+		}
+
+		r = append(r, map[string]interface{}{
+			"description": u.Description,
+			"deadline":    deadline,
+			"start_after": u.StartAfter,
+		})
+	}
+
+	return r
+}
+
+// CustomizeDiffMaintenanceUpdates plans an update of services with pending maintenance updates when
+// apply_maintenance_updates is on, so that the updates are applied.
+func CustomizeDiffMaintenanceUpdates(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	apply, ok := d.Get("apply_maintenance_updates").(bool)
+	if d.Id() == "" || !ok || !apply {
+		return nil
+	}
+
+	if updates, ok := d.Get("pending_maintenance_updates").([]interface{}); !ok || len(updates) == 0 {
+		return nil
+	}
+
+	return d.SetNewComputed("pending_maintenance_updates")
+}
+
+// applyMaintenanceUpdates starts the maintenance of the service when apply_maintenance_updates is on and it has
+// pending maintenance updates, and waits until they are applied.
+func applyMaintenanceUpdates(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	if apply, ok := d.Get("apply_maintenance_updates").(bool); !ok || !apply {
+		return nil
+	}
+
+	projectName, serviceName, err := SplitResourceID2(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := m.(*common.ProviderMeta).Client
+
+	s, err := client.Services.Get(projectName, serviceName)
+	if err != nil {
+		return fmt.Errorf("unable to get the service: %w", err)
+	}
+
+	if len(s.MaintenanceWindow.Updates) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] starting the maintenance of %s/%s, %d updates pending", projectName, serviceName, len(s.MaintenanceWindow.Updates))
+
+	if err := common.DoAPIRequest(
+		ctx,
+		client,
+		http.MethodPut,
+		fmt.Sprintf("/v1/project/%s/service/%s/maintenance/start", url.PathEscape(projectName), url.PathEscape(serviceName)),
+		nil,
+		nil,
+	); err != nil {
+		return fmt.Errorf("unable to start the maintenance: %w", err)
+	}
+
+	return WaitForMaintenanceUpdates(ctx, m, projectName, serviceName, timeout)
+}
+
+// WaitForMaintenanceUpdates waits until the service is running with no pending maintenance updates.
+// nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
+func WaitForMaintenanceUpdates(
+	ctx context.Context,
+	m interface{},
+	projectName, serviceName string,
+	timeout time.Duration,
+) error {
+	client := m.(*common.ProviderMeta).Client
+
+	log.Printf("[DEBUG] Maintenance waiter timeout %.0f minutes", timeout.Minutes())
+
+	progress := &serviceProgressLogger{}
+
+	conf := &resource.StateChangeConf{
+		Pending:                   []string{"maintenance"},
+		Target:                    []string{"done"},
+		Delay:                     10 * time.Second,
+		Timeout:                   timeout,
+		MinTimeout:                2 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Refresh: func() (interface{}, string, error) {
+			service, err := client.Services.Get(projectName, serviceName)
+			if err != nil {
+				return nil, "", fmt.Errorf("unable to fetch service from api: %w", err)
+			}
+
+			progress.log(service)
+
+			if service.State != aivenTargetState || len(service.MaintenanceWindow.Updates) > 0 {
+				log.Printf(
+					"[DEBUG] service reports as %s with %d pending maintenance updates, still waiting",
+					service.State, len(service.MaintenanceWindow.Updates),
+				)
+				return service, "maintenance", nil
+			}
+
+			return service, "done", nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		return withServiceDiagnostics(
+			fmt.Errorf("unable to wait for the maintenance updates: %w", err), m, projectName, serviceName,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package schemautil
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenMaintenanceUpdates(t *testing.T) {
+	deadline := "2023-07-20T00:00:00Z"
+
+	assert.Equal(t, []map[string]interface{}{
+		{"description": "Update to the latest PostgreSQL minor version", "deadline": deadline, "start_after": "2023-07-06T00:00:00Z"},
+		{"description": "Operating system update", "deadline": "", "start_after": ""},
+	}, FlattenMaintenanceUpdates([]*aiven.MaintenanceUpdate{
+		{Description: "Update to the latest PostgreSQL minor version", Deadline: &deadline, StartAfter: "2023-07-06T00:00:00Z"},
+		{Description: "Operating system update"},
+	}))
+
+	assert.Empty(t, FlattenMaintenanceUpdates(nil))
+}
@@ -0,0 +1,91 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// powerOffService powers off the service of d and waits until it reports as powered off.
+func powerOffService(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := m.(*common.ProviderMeta).Client
+	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+
+	vpcID, err := GetProjectVPCIdPointer(d)
+	if err != nil {
+		return fmt.Errorf("error getting project VPC ID: %w", err)
+	}
+
+	if _, err = client.Services.Update(projectName, serviceName, aiven.UpdateServiceRequest{
+		Cloud:                 d.Get("cloud_name").(string),
+		Plan:                  d.Get("plan").(string),
+		MaintenanceWindow:     GetMaintenanceWindow(d),
+		ProjectVPCID:          vpcID,
+		Powered:               false,
+		TerminationProtection: d.Get("termination_protection").(bool),
+	}); err != nil {
+		return err
+	}
+
+	InvalidateServiceList(m, projectName)
+
+	_, err = WaitForServiceUpdate(ctx, d, m)
+
+	return err
+}
+
+// ServicePoweredOff returns true if the service is powered off.
+func ServicePoweredOff(m interface{}, projectName, serviceName string) (bool, error) {
+	services, err := ListServices(m, projectName)
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range services {
+		if s.Name == serviceName {
+			return !s.Powered || s.State == aivenPoweroffState, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ResourceWithPoweredOffService keeps the state of r unchanged while its service is powered off, since the
+// resources of a powered off service, e.g. databases and users, cannot be read. This only applies to the refresh of
+// existing resources: the read at the end of a creation always runs, so that the computed attributes are set.
+// Resources that do not belong to a service, and the services themselves, are left unchanged.
+func ResourceWithPoweredOffService(r *schema.Resource) {
+	serviceName, ok := r.Schema["service_name"]
+	if !ok || !serviceName.Required || r.ReadContext == nil {
+		return
+	}
+
+	if _, ok = r.Schema["service_type"]; ok {
+		return
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+
+		if projectName != "" && serviceName != "" && !d.IsNewResource() {
+			poweredOff, err := ServicePoweredOff(m, projectName, serviceName)
+			if err != nil {
+				return diag.Errorf("error checking if service %s/%s is powered off: %s", projectName, serviceName, err)
+			}
+
+			if poweredOff {
+				log.Printf("[DEBUG] service %s/%s is powered off, keeping the state of %s", projectName, serviceName, d.Id())
+				return nil
+			}
+		}
+
+		return read(ctx, d, m)
+	}
+}
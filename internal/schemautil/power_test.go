@@ -0,0 +1,87 @@
+package schemautil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestResourceWithPoweredOffService(t *testing.T) {
+	meta := common.NewProviderMeta(&aiven.Client{}, &common.ProviderConfig{})
+
+	// Fills in the cached service list, so that no API call is made.
+	_, err := common.CachedLookup(meta.Lookups, serviceListKey("my-project"), func() ([]*aiven.Service, error) {
+		return []*aiven.Service{
+			{Name: "running-pg", State: "RUNNING", Powered: true},
+			{Name: "parked-pg", State: aivenPoweroffState, Powered: false},
+		}, nil
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := []struct {
+		name        string
+		serviceName string
+		newResource bool
+		wantRead    bool
+	}{
+		{"running_service", "running-pg", false, true},
+		{"powered_off_service", "parked-pg", false, false},
+		{"unknown_service", "missing-pg", false, true},
+		{"created_on_powered_off_service", "parked-pg", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var read bool
+
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"project":      CommonSchemaProjectReference,
+					"service_name": CommonSchemaServiceNameReference,
+				},
+				ReadContext: func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+					read = true
+					return nil
+				},
+			}
+
+			ResourceWithPoweredOffService(r)
+
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"project":      "my-project",
+				"service_name": tt.serviceName,
+			})
+			if tt.newResource {
+				d.MarkNewResource()
+			}
+
+			diags := r.ReadContext(context.Background(), d, meta)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tt.wantRead, read)
+		})
+	}
+}
+
+func TestResourceWithPoweredOffServiceSkipsServices(t *testing.T) {
+	read := func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		return nil
+	}
+
+	r := &schema.Resource{
+		Schema:      ServiceCommonSchema(),
+		ReadContext: read,
+	}
+	r.Schema["service_type"] = &schema.Schema{Type: schema.TypeString, Computed: true}
+
+	ResourceWithPoweredOffService(r)
+
+	// The read function of a service is not wrapped.
+	assert.Nil(t, r.ReadContext(context.Background(), nil, nil))
+}
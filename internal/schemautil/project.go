@@ -0,0 +1,100 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// errProjectNotSet is returned when neither the resource nor the provider sets the project.
+var errProjectNotSet = fmt.Errorf("project is not set, set it on the resource or the provider")
+
+// defaultProjectDescription is appended to the description of the project attributes filled in by the provider.
+const defaultProjectDescription = " Defaults to the `project` of the provider."
+
+// ResourceWithDefaultProject makes the required project attribute of r optional, and fills it in from the
+// provider project when it is not set. Resources without a required project attribute are left unchanged.
+func ResourceWithDefaultProject(r *schema.Resource) {
+	if !withOptionalProject(r) {
+		return
+	}
+
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = CustomizeDiffDefaultProject
+	} else {
+		r.CustomizeDiff = customdiff.Sequence(CustomizeDiffDefaultProject, r.CustomizeDiff)
+	}
+}
+
+// DatasourceWithDefaultProject makes the required project attribute of r optional, and fills it in from the
+// provider project when it is not set. Data sources without a required project attribute are left unchanged.
+func DatasourceWithDefaultProject(r *schema.Resource) {
+	if !withOptionalProject(r) {
+		return
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.Get("project").(string) == "" {
+			project := common.GetProviderConfig(m).Project
+			if project == "" {
+				return diag.FromErr(errProjectNotSet)
+			}
+
+			if err := d.Set("project", project); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return read(ctx, d, m)
+	}
+}
+
+// withOptionalProject replaces the required project attribute of r with an optional and computed copy.
+// The copy is needed because the project schema is shared between resources.
+func withOptionalProject(r *schema.Resource) bool {
+	s, ok := r.Schema["project"]
+	if !ok || !s.Required || s.Type != schema.TypeString {
+		return false
+	}
+
+	project := *s
+	project.Required = false
+	project.Optional = true
+	project.Computed = true
+	project.Description = strings.TrimSuffix(strings.TrimSpace(s.Description), ".") + "." + defaultProjectDescription
+	r.Schema["project"] = &project
+
+	return true
+}
+
+// CustomizeDiffDefaultProject plans the project of the provider when the project is not set in the configuration.
+// Changing the provider project replaces the resources relying on it, as the project is immutable.
+func CustomizeDiffDefaultProject(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.GetAttr("project").IsNull() {
+		return nil
+	}
+
+	project := common.GetProviderConfig(m).Project
+	if project == "" {
+		// Existing resources keep their project, so that unsetting the provider project has no effect on them.
+		if d.Id() == "" {
+			return errProjectNotSet
+		}
+
+		return nil
+	}
+
+	if d.Get("project").(string) == project {
+		return nil
+	}
+
+	return d.SetNew("project", project)
+}
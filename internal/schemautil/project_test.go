@@ -0,0 +1,91 @@
+package schemautil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestResourceWithDefaultProject(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project": CommonSchemaProjectReference,
+		},
+	}
+
+	ResourceWithDefaultProject(r)
+
+	s := r.Schema["project"]
+	assert.False(t, s.Required)
+	assert.True(t, s.Optional)
+	assert.True(t, s.Computed)
+	assert.True(t, s.ForceNew)
+	assert.NotNil(t, r.CustomizeDiff)
+
+	// The shared schema must not change.
+	assert.True(t, CommonSchemaProjectReference.Required)
+	assert.False(t, CommonSchemaProjectReference.Computed)
+}
+
+func TestDatasourceWithDefaultProject(t *testing.T) {
+	meta := common.NewProviderMeta(&aiven.Client{}, &common.ProviderConfig{Project: "default-project"})
+
+	tests := []struct {
+		name        string
+		meta        interface{}
+		project     string
+		wantProject string
+		wantErr     bool
+	}{
+		{
+			"provider_project",
+			meta,
+			"",
+			"default-project",
+			false,
+		},
+		{
+			"data_source_project",
+			meta,
+			"my-project",
+			"my-project",
+			false,
+		},
+		{
+			"no_project",
+			common.NewProviderMeta(&aiven.Client{}, &common.ProviderConfig{}),
+			"",
+			"",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var readProject string
+
+			r := &schema.Resource{
+				Schema: ResourceSchemaAsDatasourceSchema(map[string]*schema.Schema{
+					"project": CommonSchemaProjectReference,
+				}, "project"),
+				ReadContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+					readProject = d.Get("project").(string)
+					return nil
+				},
+			}
+
+			DatasourceWithDefaultProject(r)
+
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"project": tt.project})
+
+			diags := r.ReadContext(context.Background(), d, tt.meta)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			assert.Equal(t, tt.wantProject, readProject)
+		})
+	}
+}
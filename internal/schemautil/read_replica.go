@@ -0,0 +1,255 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// readReplicaIntegrationType is the type of the integration that makes its destination a read replica of its source.
+const readReplicaIntegrationType = "read_replica"
+
+// readReplicaNodeRole is the role of the nodes of a read replica.
+const readReplicaNodeRole = "read-replica"
+
+// PromoteToPrimarySchema returns the schema of the promote_to_primary attribute.
+func PromoteToPrimarySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "Promotes a read replica to a standalone primary service: its `read_replica` integration is " +
+			"deleted and the provider waits until the service is writable. The `read_replica` entry must be " +
+			"removed from `service_integrations` in the same change. Promotion cannot be undone, setting the " +
+			"value back to `false` has no effect.",
+	}
+}
+
+// readReplicaSource returns the source service of the read_replica entry of service_integrations, if any.
+func readReplicaSource(d ResourceStateOrResourceDiff) string {
+	integrations, ok := d.Get("service_integrations").([]interface{})
+	if !ok {
+		return ""
+	}
+
+	for _, k := range serviceIntegrationKeys(integrations) {
+		if k.integrationType == readReplicaIntegrationType {
+			return k.sourceService
+		}
+	}
+
+	return ""
+}
+
+// configuredServiceVersion returns the major version of the service type set in the user config of d, if any.
+func configuredServiceVersion(d ResourceStateOrResourceDiff, serviceType string) string {
+	key := serviceType + "_version"
+
+	if isGenericService(d) {
+		v, err := decodeRawUserConfig(d.Get("user_config").(string))
+		if err != nil {
+			return ""
+		}
+
+		s, _ := v[key].(string)
+
+		return s
+	}
+
+	s, _ := d.Get(serviceType + "_user_config.0." + key).(string)
+
+	return s
+}
+
+// CustomizeDiffReadReplica checks that a read replica is compatible with its primary service: it must be of the same
+// service type and major version, have at least the disk space used by the primary, and its plan must be offered in
+// its cloud. It also checks that a service promoted to primary is no longer declared a read replica.
+func CustomizeDiffReadReplica(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	source := readReplicaSource(d)
+
+	if promote, ok := d.Get("promote_to_primary").(bool); ok && promote && source != "" {
+		return fmt.Errorf(
+			"the read_replica integration from %s must be removed from service_integrations to promote the service",
+			source,
+		)
+	}
+
+	if source == "" {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("service_integrations", "plan", "cloud_name", "disk_space", "additional_disk_space") {
+		return nil
+	}
+
+	for _, k := range []string{"project", "service_type", "plan", "cloud_name"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	projectName := d.Get("project").(string)
+	serviceType := d.Get("service_type").(string)
+	plan := d.Get("plan").(string)
+	cloudName := d.Get("cloud_name").(string)
+
+	primary, err := m.(*common.ProviderMeta).Client.Services.Get(projectName, source)
+	if err != nil {
+		// the primary may be created in the same apply
+		if aiven.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get the primary service %s of the read replica: %w", source, err)
+	}
+
+	if primary.Type != serviceType {
+		return fmt.Errorf("the read replica must be a %s service like its primary service %s", primary.Type, source)
+	}
+
+	version := configuredServiceVersion(d, serviceType)
+	primaryVersion, _ := primary.UserConfig[serviceType+"_version"].(string)
+
+	if version != "" && primaryVersion != "" && version != primaryVersion {
+		return fmt.Errorf(
+			"the read replica version %s must be the version %s of its primary service %s", version, primaryVersion, source,
+		)
+	}
+
+	servicePlan, err := GetServicePlan(m, projectName, serviceType, plan)
+	if err != nil {
+		if !aiven.IsNotFound(err) {
+			return fmt.Errorf("unable to get the plan of the read replica: %w", err)
+		}
+		// the existing read replicas may be on plans no longer offered
+		if d.Id() != "" && !d.HasChange("plan") {
+			return nil
+		}
+		return servicePlanNotOfferedError(ctx, m, projectName, serviceType, plan, "")
+	}
+
+	diskSpaceMB := servicePlan.DiskSpaceMB
+	if ds, ok := d.GetOk("disk_space"); ok {
+		diskSpaceMB = ConvertToDiskSpaceMB(ds.(string))
+	} else if ads, ok := d.GetOk("additional_disk_space"); ok {
+		diskSpaceMB += ConvertToDiskSpaceMB(ads.(string))
+	}
+
+	if diskSpaceMB < primary.DiskSpaceMB {
+		return fmt.Errorf(
+			"the read replica disk space %s must be at least the disk space %s of its primary service %s",
+			HumanReadableByteSize(diskSpaceMB*1024*1024),
+			HumanReadableByteSize(primary.DiskSpaceMB*1024*1024),
+			source,
+		)
+	}
+
+	if _, err := GetServicePlanPricing(m, projectName, serviceType, plan, cloudName); err != nil {
+		if aiven.IsNotFound(err) {
+			return servicePlanNotOfferedError(ctx, m, projectName, serviceType, plan, cloudName)
+		}
+		return fmt.Errorf("unable to get the pricing of the read replica plan: %w", err)
+	}
+
+	return nil
+}
+
+// promoteReadReplica promotes the read replica of d to a standalone primary service, if promote_to_primary was
+// turned on, and waits until it is writable.
+func promoteReadReplica(ctx context.Context, d *schema.ResourceData, m interface{}, projectName, serviceName string) error {
+	if promote, ok := d.Get("promote_to_primary").(bool); !ok || !promote || !d.HasChange("promote_to_primary") {
+		return nil
+	}
+
+	client := m.(*common.ProviderMeta).Client
+
+	integrations, err := client.ServiceIntegrations.List(projectName, serviceName)
+	if err != nil {
+		return fmt.Errorf("unable to list the service integrations: %w", err)
+	}
+
+	for k, i := range destinationIntegrations(serviceName, integrations) {
+		if k.integrationType != readReplicaIntegrationType {
+			continue
+		}
+
+		log.Printf("[DEBUG] promoting %s/%s, deleting its read replica integration from %s", projectName, serviceName, k.sourceService)
+
+		if err := client.ServiceIntegrations.Delete(projectName, i.ServiceIntegrationID); err != nil && !aiven.IsNotFound(err) {
+			return fmt.Errorf("unable to delete the read replica integration from %s: %w", k.sourceService, err)
+		}
+	}
+
+	InvalidateServiceList(m, projectName)
+
+	return WaitForReadReplicaPromotion(ctx, m, projectName, serviceName, d.Timeout(schema.TimeoutUpdate))
+}
+
+// readReplicaPromoted returns true if the service is a running standalone service: it has no read replica
+// integration and none of its nodes is a read replica.
+func readReplicaPromoted(service *aiven.Service) bool {
+	if service.State != aivenTargetState || readReplicaIntegration(service) != nil {
+		return false
+	}
+
+	for _, n := range service.NodeStates {
+		if n.Role == readReplicaNodeRole {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WaitForReadReplicaPromotion waits until the promoted read replica is a running standalone service.
+// nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
+func WaitForReadReplicaPromotion(
+	ctx context.Context,
+	m interface{},
+	projectName, serviceName string,
+	timeout time.Duration,
+) error {
+	client := m.(*common.ProviderMeta).Client
+
+	log.Printf("[DEBUG] Read replica promotion waiter timeout %.0f minutes", timeout.Minutes())
+
+	progress := &serviceProgressLogger{}
+
+	conf := &resource.StateChangeConf{
+		Pending:                   []string{"promoting"},
+		Target:                    []string{"promoted"},
+		Delay:                     10 * time.Second,
+		Timeout:                   timeout,
+		MinTimeout:                2 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Refresh: func() (interface{}, string, error) {
+			service, err := client.Services.Get(projectName, serviceName)
+			if err != nil {
+				return nil, "", fmt.Errorf("unable to fetch service from api: %w", err)
+			}
+
+			progress.log(service)
+
+			if !readReplicaPromoted(service) {
+				log.Printf("[DEBUG] service reports as %s, still waiting for it to be promoted", service.State)
+				return service, "promoting", nil
+			}
+
+			return service, "promoted", nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		return withServiceDiagnostics(
+			fmt.Errorf("unable to wait for read replica promotion: %w", err), m, projectName, serviceName,
+		)
+	}
+
+	return nil
+}
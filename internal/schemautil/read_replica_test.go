@@ -0,0 +1,117 @@
+package schemautil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadReplicaPromoted(t *testing.T) {
+	replica, primary := "replica-pg", "primary-pg"
+
+	tests := []struct {
+		name    string
+		service *aiven.Service
+		want    bool
+	}{
+		{
+			name: "still a read replica",
+			service: &aiven.Service{
+				Name:  replica,
+				State: "RUNNING",
+				Integrations: []*aiven.ServiceIntegration{
+					{IntegrationType: "read_replica", SourceService: &primary, DestinationService: &replica},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "read replica nodes",
+			service: &aiven.Service{
+				Name:       replica,
+				State:      "RUNNING",
+				NodeStates: []*aiven.NodeState{{Name: replica + "-1", Role: "read-replica", State: "running"}},
+			},
+			want: false,
+		},
+		{
+			name:    "rebuilding",
+			service: &aiven.Service{Name: replica, State: "REBUILDING"},
+			want:    false,
+		},
+		{
+			name: "standalone",
+			service: &aiven.Service{
+				Name:       replica,
+				State:      "RUNNING",
+				NodeStates: []*aiven.NodeState{{Name: replica + "-1", Role: "master", State: "running"}},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, readReplicaPromoted(tt.service))
+		})
+	}
+}
+
+func TestCustomizeDiffReadReplicaPromotion(t *testing.T) {
+	s := ServiceCommonSchema()
+	s["promote_to_primary"] = PromoteToPrimarySchema()
+
+	// a read replica whose only integration is its read_replica one
+	state := &terraform.InstanceState{
+		ID: "my-project/replica-pg",
+		Attributes: map[string]string{
+			"id":                     "my-project/replica-pg",
+			"promote_to_primary":     "false",
+			"service_integrations.#": "1",
+			"service_integrations.0.source_service_name": "primary-pg",
+			"service_integrations.0.integration_type":    "read_replica",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "promoted",
+			config: map[string]interface{}{
+				"promote_to_primary": true,
+			},
+		},
+		{
+			name: "still_declared_a_read_replica",
+			config: map[string]interface{}{
+				"promote_to_primary": true,
+				"service_integrations": []interface{}{
+					map[string]interface{}{"source_service_name": "primary-pg", "integration_type": "read_replica"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := schema.InternalMap(s).Diff(
+				context.Background(), state, terraform.NewResourceConfigRaw(tt.config), CustomizeDiffReadReplica, nil, true,
+			)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "must be removed from service_integrations to promote the service")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "0", diff.Attributes["service_integrations.#"].New)
+		})
+	}
+}
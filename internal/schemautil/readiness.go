@@ -0,0 +1,354 @@
+package schemautil
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// readinessTimeout is the timeout of the network calls made by the readiness checks.
+const readinessTimeout = 5 * time.Second
+
+// ReadinessTarget is the service a readiness check runs against, along with the means to reach it.
+type ReadinessTarget struct {
+	Service *aiven.Service
+
+	// StaticIPs are the IDs of the static ips the service is expected to use.
+	StaticIPs []string
+
+	// ListStaticIPs lists the static ips of the project of the service.
+	ListStaticIPs func() ([]aiven.StaticIP, error)
+
+	// Dial opens a connection to the address.
+	Dial func(network, address string, timeout time.Duration) (net.Conn, error)
+
+	// Get sends a GET request to the url.
+	Get func(url string) (*http.Response, error)
+}
+
+// NewReadinessTarget returns the readiness target of the service, reached with the client of m and the network.
+func NewReadinessTarget(m interface{}, projectName string, service *aiven.Service, staticIPs []string) *ReadinessTarget {
+	httpClient := &http.Client{Timeout: readinessTimeout}
+
+	return &ReadinessTarget{
+		Service:   service,
+		StaticIPs: staticIPs,
+		ListStaticIPs: func() ([]aiven.StaticIP, error) {
+			r, err := m.(*common.ProviderMeta).Client.StaticIPs.List(projectName)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch static ips for project '%s': '%w", projectName, err)
+			}
+
+			return r.StaticIPs, nil
+		},
+		Dial: net.DialTimeout,
+		Get:  httpClient.Get,
+	}
+}
+
+// ReadinessCheck is a check a running service must pass before it is ready to use.
+type ReadinessCheck struct {
+	// Description tells what the check waits for.
+	Description string
+
+	// ServiceTypes are the service types the check applies to, all of them if empty.
+	ServiceTypes []string
+
+	// Ready returns true if the target passes the check.
+	Ready func(t *ReadinessTarget) (bool, error)
+
+	// OptIn checks are off unless turned on in readiness_checks. They connect to the service from where the
+	// provider runs, without the http_proxy and CA bundle provider options, which may not reach it.
+	OptIn bool
+}
+
+// enabledIn returns true if the check is turned on in enabled, or is on by default.
+func (c *ReadinessCheck) enabledIn(enabled map[string]bool, name string) bool {
+	if on, ok := enabled[name]; ok {
+		return on
+	}
+
+	return !c.OptIn
+}
+
+// appliesTo returns true if the check applies to the service type.
+func (c *ReadinessCheck) appliesTo(serviceType string) bool {
+	if len(c.ServiceTypes) == 0 {
+		return true
+	}
+
+	for _, t := range c.ServiceTypes {
+		if t == serviceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readinessChecks are the readiness checks by name, the names are the keys of the readiness_checks attribute.
+var readinessChecks = map[string]*ReadinessCheck{
+	"backups": {
+		Description:  "the first backup of the service is taken",
+		ServiceTypes: []string{"pg", "elasticsearch", "redis", "influxdb"},
+		Ready:        backupsReady,
+	},
+	"static_ips": {
+		Description: "the static IPs of the service are assigned to it",
+		Ready:       staticIpsReady,
+	},
+	"grafana": {
+		Description:  "Grafana is reachable",
+		ServiceTypes: []string{"grafana"},
+		Ready:        componentsReachable("grafana"),
+	},
+	"kafka": {
+		Description:  "the Kafka brokers are reachable",
+		ServiceTypes: []string{"kafka"},
+		Ready:        componentsReachable("kafka"),
+		OptIn:        true,
+	},
+	"schema_registry": {
+		Description:  "the schema registry is reachable, if enabled",
+		ServiceTypes: []string{"kafka"},
+		Ready:        componentsReachable("schema_registry"),
+		OptIn:        true,
+	},
+	"kafka_connect": {
+		Description:  "the Kafka Connect REST API responds, if enabled",
+		ServiceTypes: []string{"kafka", "kafka_connect"},
+		Ready:        kafkaConnectReady,
+		OptIn:        true,
+	},
+	"opensearch_dashboards": {
+		Description:  "OpenSearch Dashboards is reachable, if enabled",
+		ServiceTypes: []string{"opensearch"},
+		Ready:        componentsReachable("opensearch_dashboards"),
+		OptIn:        true,
+	},
+	"read_replica": {
+		Description: "the replication from the primary service of a read replica is established",
+		Ready:       readReplicaReady,
+	},
+}
+
+// ReadinessCheckNames returns the sorted names of the readiness checks.
+func ReadinessCheckNames() []string {
+	names := make([]string, 0, len(readinessChecks))
+	for name := range readinessChecks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ServiceNotReady runs the readiness checks that apply to the target service and are enabled, and returns the name of
+// the first one that fails, an empty string if the service is ready.
+func ServiceNotReady(t *ReadinessTarget, enabled map[string]bool) (string, error) {
+	for _, name := range ReadinessCheckNames() {
+		c := readinessChecks[name]
+		if !c.enabledIn(enabled, name) || !c.appliesTo(t.Service.Type) {
+			continue
+		}
+
+		ready, err := c.Ready(t)
+		if err != nil {
+			return "", fmt.Errorf("unable to check if %s: %w", c.Description, err)
+		}
+
+		if !ready {
+			return name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// ReadinessChecksFromSchema returns the readiness checks turned on or off in the readiness_checks attribute.
+func ReadinessChecksFromSchema(d ResourceStateOrResourceDiff) map[string]bool {
+	enabled := make(map[string]bool)
+
+	raw, ok := d.Get("readiness_checks").(map[string]interface{})
+	if !ok {
+		return enabled
+	}
+
+	for name, on := range raw {
+		enabled[name] = on.(bool)
+	}
+
+	return enabled
+}
+
+// ReadinessChecksSchema returns the schema of the readiness_checks attribute.
+func ReadinessChecksSchema() *schema.Schema {
+	checks := make([]string, 0, len(readinessChecks))
+	optIn := make([]string, 0, len(readinessChecks))
+	for _, name := range ReadinessCheckNames() {
+		checks = append(checks, fmt.Sprintf("`%s` waits until %s", name, readinessChecks[name].Description))
+
+		if readinessChecks[name].OptIn {
+			optIn = append(optIn, fmt.Sprintf("`%s`", name))
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Description: "Turns the readiness checks a running service must pass before it is ready to use on or off " +
+			"by name, all the checks that apply to the service type are on by default except " +
+			strings.Join(optIn, ", ") + ". These connect to the service directly from where the provider runs, " +
+			"without the `http_proxy` and CA bundle provider options. " + strings.Join(checks, ", ") + ".",
+		Elem: &schema.Schema{Type: schema.TypeBool},
+		ValidateFunc: func(i interface{}, k string) (warnings []string, errs []error) {
+			for name := range i.(map[string]interface{}) {
+				if _, ok := readinessChecks[name]; !ok {
+					errs = append(errs, fmt.Errorf("%s: unknown readiness check %q, expected one of %v", k, name, ReadinessCheckNames()))
+				}
+			}
+
+			return warnings, errs
+		},
+	}
+}
+
+// backupsReady checks that the service has a backup, unless it does not take backups.
+func backupsReady(t *ReadinessTarget) (bool, error) {
+	service := t.Service
+
+	if service.Type == "redis" && service.UserConfig["redis_persistence"] == "off" {
+		return true, nil
+	}
+
+	// no backups for read replicas type of service
+	if readReplicaIntegration(service) != nil {
+		return true, nil
+	}
+
+	return len(service.Backups) > 0, nil
+}
+
+// staticIpsReady checks that the expected static ips that are associated with the service are either
+// in state 'assigned' or 'available'
+func staticIpsReady(t *ReadinessTarget) (bool, error) {
+	if len(t.StaticIPs) == 0 {
+		return true, nil
+	}
+
+	staticIpsList, err := t.ListStaticIPs()
+	if err != nil {
+		return false, err
+	}
+
+L:
+	for _, eip := range t.StaticIPs {
+		for _, sip := range staticIpsList {
+			assignedOrAvailable := sip.State == StaticIPAssigned || sip.State == StaticIPAvailable
+			belongsToService := sip.ServiceName == t.Service.Name
+			isExpectedIP := sip.StaticIPAddressID == eip
+
+			if isExpectedIP && belongsToService && assignedOrAvailable {
+				continue L
+			}
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// readReplicaReady checks that the read replica integration of the service is active, the service passes
+// if it is not a read replica.
+func readReplicaReady(t *ReadinessTarget) (bool, error) {
+	i := readReplicaIntegration(t.Service)
+	if i == nil {
+		return true, nil
+	}
+
+	return i.Enabled && i.Active, nil
+}
+
+// readReplicaIntegration returns the integration that makes the service a read replica, nil if there is none.
+func readReplicaIntegration(service *aiven.Service) *aiven.ServiceIntegration {
+	for _, i := range service.Integrations {
+		if i.IntegrationType == readReplicaIntegrationType && i.DestinationService != nil && *i.DestinationService == service.Name {
+			return i
+		}
+	}
+
+	return nil
+}
+
+// reachableFromProvider returns true if the provider can be expected to reach the service over the network: the
+// service is not in a VPC and its IP filter allows any address.
+func reachableFromProvider(service *aiven.Service) bool {
+	ipFilters, ok := service.UserConfig["ip_filter"]
+	if ok {
+		f, _ := ipFilters.([]interface{})
+		if len(f) > 1 || (len(f) == 1 && f[0] != "0.0.0.0/0") {
+			log.Printf("[DEBUG] service has `%+v` ip filters, and availability checks will be skipped", ipFilters)
+
+			return false
+		}
+	}
+
+	return service.ProjectVPCID == nil
+}
+
+// componentsReachable returns a readiness check that the public endpoints of the component accept connections.
+// Components of services the provider cannot reach pass the check.
+func componentsReachable(component string) func(t *ReadinessTarget) (bool, error) {
+	return func(t *ReadinessTarget) (bool, error) {
+		if !reachableFromProvider(t.Service) {
+			return true, nil
+		}
+
+		for _, c := range t.Service.Components {
+			if c.Component != component || (c.Route != "public" && c.Route != "dynamic") {
+				continue
+			}
+
+			address := net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+
+			conn, err := t.Dial("tcp", address, readinessTimeout)
+			if err != nil {
+				log.Printf("[DEBUG] %s at %s is not yet reachable: %s", component, address, err)
+				return false, nil
+			}
+
+			_ = conn.Close()
+		}
+
+		return true, nil
+	}
+}
+
+// kafkaConnectReady checks that the Kafka Connect REST API of the service responds, if Kafka Connect is enabled.
+func kafkaConnectReady(t *ReadinessTarget) (bool, error) {
+	uri := t.Service.ConnectionInfo.KafkaConnectURI
+	if uri == "" || !reachableFromProvider(t.Service) {
+		return true, nil
+	}
+
+	rsp, err := t.Get(uri)
+	if err != nil {
+		log.Printf("[DEBUG] Kafka Connect REST API is not yet responding: %s", err)
+		return false, nil
+	}
+
+	_ = rsp.Body.Close()
+
+	return rsp.StatusCode < http.StatusInternalServerError, nil
+}
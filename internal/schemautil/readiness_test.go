@@ -0,0 +1,239 @@
+package schemautil
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeReadinessTarget returns a readiness target of the service that reaches the given addresses only.
+func fakeReadinessTarget(service *aiven.Service, reachable ...string) *ReadinessTarget {
+	return &ReadinessTarget{
+		Service: service,
+		ListStaticIPs: func() ([]aiven.StaticIP, error) {
+			return nil, nil
+		},
+		Dial: func(_, address string, _ time.Duration) (net.Conn, error) {
+			for _, a := range reachable {
+				if a == address {
+					c, _ := net.Pipe()
+					return c, nil
+				}
+			}
+			return nil, errors.New("connection refused")
+		},
+		Get: func(url string) (*http.Response, error) {
+			for _, a := range reachable {
+				if a == url {
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+				}
+			}
+			return nil, errors.New("connection refused")
+		},
+	}
+}
+
+func TestServiceNotReady(t *testing.T) {
+	replica := "replica-pg"
+
+	tests := []struct {
+		name      string
+		service   *aiven.Service
+		reachable []string
+		enabled   map[string]bool
+		want      string
+	}{
+		{
+			name:    "pg_without_backups",
+			service: &aiven.Service{Type: "pg"},
+			want:    "backups",
+		},
+		{
+			name:    "pg_without_backups_check_off",
+			service: &aiven.Service{Type: "pg"},
+			enabled: map[string]bool{"backups": false},
+			want:    "",
+		},
+		{
+			name:    "pg_with_backups",
+			service: &aiven.Service{Type: "pg", Backups: []*aiven.Backup{{}}},
+			want:    "",
+		},
+		{
+			name: "pg_read_replica_without_replication",
+			service: &aiven.Service{
+				Name: replica,
+				Type: "pg",
+				Integrations: []*aiven.ServiceIntegration{
+					{IntegrationType: "read_replica", DestinationService: &replica},
+				},
+			},
+			want: "read_replica",
+		},
+		{
+			name: "pg_read_replica",
+			service: &aiven.Service{
+				Name: replica,
+				Type: "pg",
+				Integrations: []*aiven.ServiceIntegration{
+					{IntegrationType: "read_replica", DestinationService: &replica, Active: true, Enabled: true},
+				},
+			},
+			want: "",
+		},
+		{
+			name:    "redis_without_persistence",
+			service: &aiven.Service{Type: "redis", UserConfig: map[string]interface{}{"redis_persistence": "off"}},
+			want:    "",
+		},
+		{
+			// the checks connecting to the service are off by default
+			name: "kafka_unreachable_checks_off",
+			service: &aiven.Service{
+				Type: "kafka",
+				Components: []*aiven.ServiceComponents{
+					{Component: "kafka", Host: "kafka.aivencloud.com", Port: 12345, Route: "dynamic"},
+					{Component: "schema_registry", Host: "kafka.aivencloud.com", Port: 12346, Route: "dynamic"},
+				},
+				ConnectionInfo: aiven.ConnectionInfo{KafkaConnectURI: "https://connect.aivencloud.com:443"},
+			},
+			want: "",
+		},
+		{
+			name:    "kafka_brokers_unreachable",
+			enabled: map[string]bool{"kafka": true},
+			service: &aiven.Service{
+				Type: "kafka",
+				Components: []*aiven.ServiceComponents{
+					{Component: "kafka", Host: "kafka.aivencloud.com", Port: 12345, Route: "dynamic"},
+				},
+			},
+			want: "kafka",
+		},
+		{
+			name:    "kafka_schema_registry_unreachable",
+			enabled: map[string]bool{"kafka": true, "schema_registry": true},
+			service: &aiven.Service{
+				Type: "kafka",
+				Components: []*aiven.ServiceComponents{
+					{Component: "kafka", Host: "kafka.aivencloud.com", Port: 12345, Route: "dynamic"},
+					{Component: "schema_registry", Host: "kafka.aivencloud.com", Port: 12346, Route: "dynamic"},
+				},
+			},
+			reachable: []string{"kafka.aivencloud.com:12345"},
+			want:      "schema_registry",
+		},
+		{
+			name:    "kafka_reachable",
+			enabled: map[string]bool{"kafka": true},
+			service: &aiven.Service{
+				Type: "kafka",
+				Components: []*aiven.ServiceComponents{
+					{Component: "kafka", Host: "kafka.aivencloud.com", Port: 12345, Route: "dynamic"},
+					{Component: "kafka", Host: "kafka.private", Port: 12345, Route: "private"},
+				},
+			},
+			reachable: []string{"kafka.aivencloud.com:12345"},
+			want:      "",
+		},
+		{
+			name:    "kafka_in_vpc",
+			enabled: map[string]bool{"kafka": true},
+			service: &aiven.Service{
+				Type:         "kafka",
+				ProjectVPCID: new(string),
+				Components: []*aiven.ServiceComponents{
+					{Component: "kafka", Host: "kafka.aivencloud.com", Port: 12345, Route: "dynamic"},
+				},
+			},
+			want: "",
+		},
+		{
+			name:    "kafka_connect_not_responding",
+			enabled: map[string]bool{"kafka_connect": true},
+			service: &aiven.Service{
+				Type:           "kafka_connect",
+				ConnectionInfo: aiven.ConnectionInfo{KafkaConnectURI: "https://connect.aivencloud.com:443"},
+			},
+			want: "kafka_connect",
+		},
+		{
+			name:    "kafka_connect_responding",
+			enabled: map[string]bool{"kafka_connect": true},
+			service: &aiven.Service{
+				Type:           "kafka_connect",
+				ConnectionInfo: aiven.ConnectionInfo{KafkaConnectURI: "https://connect.aivencloud.com:443"},
+			},
+			reachable: []string{"https://connect.aivencloud.com:443"},
+			want:      "",
+		},
+		{
+			name:    "opensearch_dashboards_unreachable",
+			enabled: map[string]bool{"opensearch_dashboards": true},
+			service: &aiven.Service{
+				Type: "opensearch",
+				Components: []*aiven.ServiceComponents{
+					{Component: "opensearch_dashboards", Host: "os.aivencloud.com", Port: 443, Route: "public"},
+				},
+			},
+			want: "opensearch_dashboards",
+		},
+		{
+			name: "grafana_with_ip_filter",
+			service: &aiven.Service{
+				Type:       "grafana",
+				UserConfig: map[string]interface{}{"ip_filter": []interface{}{"10.0.0.0/8"}},
+				Components: []*aiven.ServiceComponents{
+					{Component: "grafana", Host: "grafana.aivencloud.com", Port: 443, Route: "public"},
+				},
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ServiceNotReady(fakeReadinessTarget(tt.service, tt.reachable...), tt.enabled)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStaticIpsReady(t *testing.T) {
+	target := fakeReadinessTarget(&aiven.Service{Name: "my-pg", Type: "pg"})
+	target.StaticIPs = []string{"ip1", "ip2"}
+	target.ListStaticIPs = func() ([]aiven.StaticIP, error) {
+		return []aiven.StaticIP{
+			{StaticIPAddressID: "ip1", ServiceName: "my-pg", State: StaticIPAssigned},
+			{StaticIPAddressID: "ip2", ServiceName: "my-pg", State: "creating"},
+		}, nil
+	}
+
+	ready, err := staticIpsReady(target)
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	target.ListStaticIPs = func() ([]aiven.StaticIP, error) {
+		return nil, errors.New("unavailable")
+	}
+
+	_, err = ServiceNotReady(target, map[string]bool{"backups": false})
+	assert.ErrorContains(t, err, "unavailable")
+}
+
+func TestReadinessChecksSchema(t *testing.T) {
+	validate := ReadinessChecksSchema().ValidateFunc
+
+	_, errs := validate(map[string]interface{}{"backups": false, "kafka": true}, "readiness_checks")
+	assert.Empty(t, errs)
+
+	_, errs = validate(map[string]interface{}{"backup": false}, "readiness_checks")
+	assert.Len(t, errs, 1)
+}
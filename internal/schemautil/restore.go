@@ -0,0 +1,270 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
+)
+
+const (
+	// forkFromUserConfigKey is the user config property naming the service a new service is forked from.
+	forkFromUserConfigKey = "service_to_fork_from"
+
+	// recoveryTargetTimeUserConfigKey is the user config property with the point in time a fork is recovered to.
+	recoveryTargetTimeUserConfigKey = "recovery_target_time"
+)
+
+// RestoreFromSchema returns the schema of the restore_from block, which creates a service from the backups of
+// another service.
+func RestoreFromSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		MaxItems:         1,
+		DiffSuppressFunc: diffSuppressRestoreFrom,
+		Description: "Creates the service from the backups of another service of the same type. It is checked at " +
+			"plan time against the backups of the source service and only has effect when the service is created, " +
+			"changing or removing it afterwards has no effect.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: diffSuppressRestoreFrom,
+					Description:      "Name of the service of the same project to restore the backups of.",
+				},
+				"point_in_time": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: diffSuppressRestoreFrom,
+					Description: "RFC3339 timestamp to recover the data to, within the point-in-time recovery window " +
+						"of the source service. The latest backup is restored when it is not set. Only supported by " +
+						"service types with point-in-time recovery, e.g. PostgreSQL and MySQL.",
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+			},
+		},
+	}
+}
+
+// diffSuppressRestoreFrom suppresses the changes of the restore_from block of existing services, it only has effect
+// when the service is created. The block is kept in the state, so that the user config properties it set are still
+// left out of the user config.
+func diffSuppressRestoreFrom(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+// restoreFrom returns the source service and point in time of the restore_from block of d, if any.
+func restoreFrom(d ResourceStateOrResourceDiff) (service, pointInTime string, ok bool) {
+	l, ok := d.Get("restore_from").([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return "", "", false
+	}
+
+	v := l[0].(map[string]interface{})
+	service, _ = v["service"].(string)
+	pointInTime, _ = v["point_in_time"].(string)
+
+	return service, pointInTime, true
+}
+
+// applyRestoreFrom adds the user config properties that fork the service from the restore_from block of d.
+func applyRestoreFrom(d *schema.ResourceData, userConfig map[string]interface{}) map[string]interface{} {
+	service, pointInTime, ok := restoreFrom(d)
+	if !ok {
+		return userConfig
+	}
+
+	if userConfig == nil {
+		userConfig = make(map[string]interface{})
+	}
+
+	userConfig[forkFromUserConfigKey] = service
+
+	if pointInTime != "" {
+		userConfig[recoveryTargetTimeUserConfigKey] = pointInTime
+	}
+
+	return userConfig
+}
+
+// withoutRestoreFrom returns the user config returned by the API without the properties set from the restore_from
+// block of d, so that they do not show as drift of the user config.
+func withoutRestoreFrom(d ResourceStateOrResourceDiff, userConfig map[string]interface{}) map[string]interface{} {
+	if _, _, ok := restoreFrom(d); !ok {
+		return userConfig
+	}
+
+	r := make(map[string]interface{}, len(userConfig))
+
+	for k, v := range userConfig {
+		if k == forkFromUserConfigKey || k == recoveryTargetTimeUserConfigKey {
+			continue
+		}
+
+		r[k] = v
+	}
+
+	return r
+}
+
+// userConfigHasProperty returns true if the user config of the service type has the property. Service types
+// without a user config schema are expected to have it.
+func userConfigHasProperty(serviceType, property string) bool {
+	rm, err := userconfig.CachedRepresentationMap(userconfig.ServiceTypes)
+	if err != nil {
+		return true
+	}
+
+	s, ok := rm[serviceType].(map[string]interface{})
+	if !ok {
+		return true
+	}
+
+	props, _ := s["properties"].(map[string]interface{})
+	_, ok = props[property]
+
+	return ok
+}
+
+// BackupWindow is the window of time the backups of a service can be restored to.
+type BackupWindow struct {
+	// Start is the time of the oldest backup.
+	Start time.Time
+	// End is the time of the latest backup, or now when the service supports point-in-time recovery.
+	End time.Time
+}
+
+// Contains returns true if t is within the window.
+func (w BackupWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && !t.After(w.End)
+}
+
+// ServiceBackupWindow returns the window of time the backups of the service can be restored to, ok is false when the
+// service has no backups. With point-in-time recovery, the data can be recovered up to now.
+func ServiceBackupWindow(service *aiven.Service, pointInTimeRecovery bool, now time.Time) (w BackupWindow, ok bool) {
+	times := make([]time.Time, 0, len(service.Backups))
+
+	for _, b := range service.Backups {
+		t, err := time.Parse(time.RFC3339, b.BackupTime)
+		if err != nil {
+			continue
+		}
+
+		times = append(times, t)
+	}
+
+	if len(times) == 0 {
+		return w, false
+	}
+
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	w.Start, w.End = times[0], times[len(times)-1]
+
+	if pointInTimeRecovery {
+		w.End = now
+	}
+
+	return w, true
+}
+
+// SupportsPointInTimeRecovery returns true if services of the type can be recovered to a point in time.
+func SupportsPointInTimeRecovery(serviceType string) bool {
+	return userConfigHasProperty(serviceType, recoveryTargetTimeUserConfigKey)
+}
+
+// CustomizeDiffRestoreFrom checks the restore_from block of a new service: the service type must support forks, the
+// user config must not fork the service too, and the source service must be of the same type and have backups
+// covering the point in time.
+func CustomizeDiffRestoreFrom(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("restore_from") {
+		return nil
+	}
+
+	source, pointInTime, ok := restoreFrom(d)
+	if !ok || source == "" || !d.NewValueKnown("project") || !d.NewValueKnown("service_type") {
+		return nil
+	}
+
+	projectName := d.Get("project").(string)
+	serviceType := d.Get("service_type").(string)
+
+	if !userConfigHasProperty(serviceType, forkFromUserConfigKey) {
+		return fmt.Errorf("restore_from is not supported by %s services", serviceType)
+	}
+
+	if pointInTime != "" && !SupportsPointInTimeRecovery(serviceType) {
+		return fmt.Errorf("restore_from.point_in_time is not supported by %s services", serviceType)
+	}
+
+	if hasForkUserConfig(d, serviceType) {
+		return fmt.Errorf(
+			"restore_from cannot be used with %s or %s in the user config", forkFromUserConfigKey, recoveryTargetTimeUserConfigKey,
+		)
+	}
+
+	s, err := m.(*common.ProviderMeta).Client.Services.Get(projectName, source)
+	if err != nil {
+		if aiven.IsNotFound(err) {
+			return fmt.Errorf("the service %s to restore from does not exist", source)
+		}
+		return fmt.Errorf("unable to get the service %s to restore from: %w", source, err)
+	}
+
+	if s.Type != serviceType {
+		return fmt.Errorf("unable to restore a %s service from the %s service %s", serviceType, s.Type, source)
+	}
+
+	w, ok := ServiceBackupWindow(s, SupportsPointInTimeRecovery(serviceType), time.Now())
+	if !ok {
+		return fmt.Errorf("the service %s to restore from has no backups", source)
+	}
+
+	if pointInTime == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, pointInTime)
+	if err != nil {
+		return fmt.Errorf("invalid restore_from.point_in_time: %w", err)
+	}
+
+	if !w.Contains(t) {
+		return fmt.Errorf(
+			"restore_from.point_in_time %s is out of the backup window of the service %s, from %s to %s",
+			pointInTime, source, w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339),
+		)
+	}
+
+	return nil
+}
+
+// hasForkUserConfig returns true if the user config of d sets the fork properties.
+func hasForkUserConfig(d ResourceStateOrResourceDiff, serviceType string) bool {
+	if isGenericService(d) {
+		v, err := decodeRawUserConfig(d.Get("user_config").(string))
+		if err != nil {
+			return false
+		}
+
+		_, fork := v[forkFromUserConfigKey]
+		_, recovery := v[recoveryTargetTimeUserConfigKey]
+
+		return fork || recovery
+	}
+
+	prefix := serviceType + "_user_config.0."
+	fork, _ := d.Get(prefix + forkFromUserConfigKey).(string)
+	recovery, _ := d.Get(prefix + recoveryTargetTimeUserConfigKey).(string)
+
+	return fork != "" || recovery != ""
+}
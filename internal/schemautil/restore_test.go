@@ -0,0 +1,103 @@
+package schemautil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceBackupWindow(t *testing.T) {
+	now := time.Date(2023, 7, 3, 12, 0, 0, 0, time.UTC)
+
+	s := &aiven.Service{
+		Backups: []*aiven.Backup{
+			{BackupName: "b2", BackupTime: "2023-07-02T00:00:00Z"},
+			{BackupName: "b1", BackupTime: "2023-07-01T00:00:00.123Z"},
+			{BackupName: "invalid", BackupTime: "yesterday"},
+		},
+	}
+
+	w, ok := ServiceBackupWindow(s, false, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 7, 1, 0, 0, 0, 123000000, time.UTC), w.Start)
+	assert.Equal(t, time.Date(2023, 7, 2, 0, 0, 0, 0, time.UTC), w.End)
+	assert.True(t, w.Contains(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, w.Contains(time.Date(2023, 7, 2, 12, 0, 0, 0, time.UTC)))
+
+	// point-in-time recovery recovers up to now
+	w, ok = ServiceBackupWindow(s, true, now)
+	assert.True(t, ok)
+	assert.Equal(t, now, w.End)
+	assert.True(t, w.Contains(time.Date(2023, 7, 2, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, w.Contains(time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC)))
+
+	_, ok = ServiceBackupWindow(&aiven.Service{}, true, now)
+	assert.False(t, ok)
+}
+
+func TestRestoreFromUserConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ServiceCommonSchema(), map[string]interface{}{
+		"restore_from": []interface{}{
+			map[string]interface{}{"service": "source-pg", "point_in_time": "2023-07-01T12:00:00Z"},
+		},
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"pg_version":           "15",
+		"service_to_fork_from": "source-pg",
+		"recovery_target_time": "2023-07-01T12:00:00Z",
+	}, applyRestoreFrom(d, map[string]interface{}{"pg_version": "15"}))
+
+	assert.Equal(t, map[string]interface{}{"pg_version": "15"}, withoutRestoreFrom(d, map[string]interface{}{
+		"pg_version":           "15",
+		"service_to_fork_from": "source-pg",
+		"recovery_target_time": "2023-07-01T12:00:00Z",
+	}))
+
+	// without restore_from, the user config is left as is
+	d = schema.TestResourceDataRaw(t, ServiceCommonSchema(), map[string]interface{}{})
+	assert.Nil(t, applyRestoreFrom(d, nil))
+	assert.Equal(t, map[string]interface{}{"service_to_fork_from": "source-pg"}, withoutRestoreFrom(d, map[string]interface{}{
+		"service_to_fork_from": "source-pg",
+	}))
+}
+
+func TestSupportsPointInTimeRecovery(t *testing.T) {
+	assert.True(t, SupportsPointInTimeRecovery(ServiceTypePG))
+	assert.False(t, SupportsPointInTimeRecovery(ServiceTypeKafka))
+}
+
+func TestRestoreFromDiff(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{"restore_from": RestoreFromSchema()}}
+
+	// the block of a new service is planned
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"restore_from": []interface{}{map[string]interface{}{"service": "my-pg"}},
+	}), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.Contains(t, diff.Attributes, "restore_from.0.service")
+
+	// removing the block of an existing service has no diff
+	state := &terraform.InstanceState{
+		ID: "my-project/my-pg-restored",
+		Attributes: map[string]string{
+			"id":                     "my-project/my-pg-restored",
+			"restore_from.#":         "1",
+			"restore_from.0.service": "my-pg",
+		},
+	}
+
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{}), nil)
+	require.NoError(t, err)
+	if diff != nil {
+		assert.Empty(t, diff.Attributes)
+		assert.False(t, diff.RequiresNew())
+	}
+}
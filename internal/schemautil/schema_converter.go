@@ -4,9 +4,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// resourceOnlyAttributes are the attributes that configure how a resource is managed, they are left out of the
+// data sources built from the resource schema.
+var resourceOnlyAttributes = map[string]bool{
+	"wait_for_state":            true,
+	"readiness_checks":          true,
+	"promote_to_primary":        true,
+	"restore_from":              true,
+	"apply_maintenance_updates": true,
+}
+
 func ResourceSchemaAsDatasourceSchema(d map[string]*schema.Schema, required ...string) map[string]*schema.Schema {
 	s := make(map[string]*schema.Schema)
 	for k, v := range d {
+		if resourceOnlyAttributes[k] {
+			continue
+		}
+
 		s[k] = &schema.Schema{
 			Type:        v.Type,
 			Computed:    true,
@@ -65,3 +65,19 @@ func Test_resourceSchemaAsDatasourceSchema(t *testing.T) {
 		})
 	}
 }
+
+func Test_resourceSchemaAsDatasourceSchemaResourceOnly(t *testing.T) {
+	s := ResourceSchemaAsDatasourceSchema(ServiceCommonSchema(), "project", "service_name")
+
+	assert.NotContains(t, s, "wait_for_state")
+	assert.NotContains(t, s, "restore_from")
+	assert.Contains(t, s, "powered")
+}
+
+func TestServiceCommonSchemaWaitForStateDiffSuppress(t *testing.T) {
+	suppress := ServiceCommonSchema()["wait_for_state"].DiffSuppressFunc
+
+	assert.True(t, suppress("wait_for_state", "", WaitForStateRunning, nil))
+	assert.False(t, suppress("wait_for_state", "", WaitForStateNone, nil))
+	assert.False(t, suppress("wait_for_state", WaitForStateNone, WaitForStateRunning, nil))
+}
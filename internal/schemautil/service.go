@@ -3,6 +3,7 @@ package schemautil
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/docker/go-units"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/apiconvert"
 
@@ -105,13 +107,47 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Prevents the service from being deleted. It is recommended to set this to `true` for all production services to prevent unintentional service deletion. This does not shield against deleting databases or topics but for services with backups much of the content can at least be restored from backup in case accidental deletion is done.",
 		},
+		"powered": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+			Description: "Whether the service is powered on. A powered off service keeps its configuration and " +
+				"backups but its nodes are stopped and it is not billed. Kafka topics are lost when the service is " +
+				"powered off. Resources of a powered off service, such as databases and users, are not refreshed " +
+				"until it is powered on again. The default value is `true`.",
+		},
+		"wait_for_state": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  WaitForStateRunning,
+			Description: userconfig.Desc("The state to wait for when the service is created or updated. With `running` "+
+				"the service must be running and ready to use, e.g. its backups and static IPs are ready. With "+
+				"`rebuilding_ok` the service may still be rebuilding, and with `none` there is no waiting at all. "+
+				"New services are rebuilding right after they are created, so with `rebuilding_ok` the creation "+
+				"does not wait either. Use the `aiven_service_readiness` data source to wait for the service later.").
+				PossibleValues(WaitForStateRunning, WaitForStateRebuildingOK, WaitForStateNone).
+				DefaultValue(WaitForStateRunning).Build(),
+			ValidateFunc: validation.StringInSlice(
+				[]string{WaitForStateRunning, WaitForStateRebuildingOK, WaitForStateNone}, false,
+			),
+			// services imported or created before the attribute existed have no value for it
+			DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+				return old == "" && new == WaitForStateRunning
+			},
+		},
+		"readiness_checks":            ReadinessChecksSchema(),
+		"restore_from":                RestoreFromSchema(),
+		"pending_maintenance_updates": PendingMaintenanceUpdatesSchema(),
+		"apply_maintenance_updates":   ApplyMaintenanceUpdatesSchema(),
+		"estimated_monthly_cost_usd":  EstimatedMonthlyCostSchema(),
 		"disk_space": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Description:   "Service disk space. Possible values depend on the service type, the cloud provider and the project. Therefore, reducing will result in the service rebalancing.",
-			ValidateFunc:  ValidateHumanByteSizeString,
-			ConflictsWith: []string{"additional_disk_space"},
-			Deprecated:    "This will be removed in v5.0.0 and replaced with additional_disk_space instead.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Service disk space. Possible values depend on the service type, the cloud provider and the project. Therefore, reducing will result in the service rebalancing.",
+			ValidateFunc:     ValidateHumanByteSizeString,
+			ConflictsWith:    []string{"additional_disk_space"},
+			DiffSuppressFunc: DiffSuppressEquivalentDiskSpace,
+			Deprecated:       "This will be removed in v5.0.0 and replaced with additional_disk_space instead.",
 		},
 		"disk_space_used": {
 			Type:        schema.TypeString,
@@ -124,11 +160,12 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 			Description: "The default disk space of the service, possible values depend on the service type, the cloud provider and the project. Its also the minimum value for `disk_space`",
 		},
 		"additional_disk_space": {
-			Type:          schema.TypeString,
-			Optional:      true,
-			Description:   "Additional disk space. Possible values depend on the service type, the cloud provider and the project. Therefore, reducing will result in the service rebalancing.",
-			ValidateFunc:  ValidateHumanByteSizeString,
-			ConflictsWith: []string{"disk_space"},
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Additional disk space. Possible values depend on the service type, the cloud provider and the project. Therefore, reducing will result in the service rebalancing.",
+			ValidateFunc:     ValidateHumanByteSizeString,
+			ConflictsWith:    []string{"disk_space"},
+			DiffSuppressFunc: DiffSuppressEquivalentDiskSpace,
 		},
 		"disk_space_step": {
 			Type:        schema.TypeString,
@@ -175,7 +212,7 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 		"service_integrations": {
 			Type:        schema.TypeList,
 			Optional:    true,
-			Description: "Service integrations to specify when creating a service. Not applied after initial service creation",
+			Description: "Service integrations this service is the destination of. Integrations added to or removed from it after the service creation are created or deleted. Only these integrations are read back: integrations managed otherwise, e.g. with the `aiven_service_integration` resource or in the console, are left out and never deleted.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"source_service_name": {
@@ -186,7 +223,7 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 					"integration_type": {
 						Type:        schema.TypeString,
 						Required:    true,
-						Description: "Type of the service integration. The only supported value at the moment is `read_replica`",
+						Description: "Type of the service integration, e.g. `read_replica` or `metrics`",
 					},
 				},
 			},
@@ -243,6 +280,7 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"tags_all": CommonSchemaTagsAll,
 		"tag": {
 			Description: "Tags are key-value pairs that allow you to categorize services.",
 			Type:        schema.TypeSet,
@@ -266,51 +304,9 @@ func ServiceCommonSchema() map[string]*schema.Schema {
 }
 
 func ResourceServiceCreateWrapper(serviceType string) schema.CreateContextFunc {
+	// the generic service has its service type set in the configuration and no per service type blocks
 	if serviceType == "service" {
-		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			// Need to set empty value for all services or all Terraform keeps on showing there's
-			// a change in the computed values that don't match actual service type
-			if err := d.Set(ServiceTypeCassandra, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeElasticsearch, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeGrafana, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeInfluxDB, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeKafka, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeKafkaConnect, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeKafkaMirrormaker, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeMySQL, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypePG, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeRedis, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeOpensearch, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeFlink, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			if err := d.Set(ServiceTypeClickhouse, []map[string]interface{}{}); err != nil {
-				return diag.FromErr(err)
-			}
-			return resourceServiceCreate(ctx, d, m)
-		}
+		return resourceServiceCreate
 	}
 
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -326,7 +322,18 @@ func ResourceServiceCreateWrapper(serviceType string) schema.CreateContextFunc {
 }
 
 func ResourceServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	return resourceServiceRead(ctx, d, m, false)
+}
+
+// resourceServiceRead reads the service of d, readFullUserConfig reads all the options of the raw user_config of the
+// generic service instead of only the ones that are set, which data sources need.
+func resourceServiceRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	readFullUserConfig bool,
+) diag.Diagnostics {
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, err := SplitResourceID2(d.Id())
 	if err != nil {
@@ -341,16 +348,38 @@ func ResourceServiceRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return nil
 	}
 
-	servicePlanParams, err := GetServicePlanParametersFromServiceResponse(ctx, client, projectName, s)
+	servicePlanParams, err := GetServicePlanParametersFromServiceResponse(ctx, m, projectName, s)
 	if err != nil {
 		return diag.Errorf("unable to get service plan parameters: %s", err)
 	}
 
-	err = copyServicePropertiesFromAPIResponseToTerraform(d, s, servicePlanParams, projectName)
+	var rawConnectionInfo map[string]interface{}
+	if rawConnectionInfoServiceTypes[s.Type] && !isGenericService(d) {
+		rawConnectionInfo, err = getRawConnectionInfo(ctx, m, projectName, serviceName)
+		if err != nil {
+			return diag.Errorf("unable to get service connection info: %s", err)
+		}
+	}
+
+	err = copyServicePropertiesFromAPIResponseToTerraform(
+		d, s, servicePlanParams, projectName, rawConnectionInfo, readFullUserConfig,
+	)
 	if err != nil {
 		return diag.Errorf("unable to copy api response into terraform schema: %s", err)
 	}
 
+	estimate, err := getMonthlyCostEstimate(
+		m, projectName, s.Type, s.Plan, s.CloudName, s.DiskSpaceMB-servicePlanParams.DiskSizeMBDefault,
+	)
+	if err != nil {
+		// the estimate is informational, e.g. tokens without billing access cannot read the pricing
+		log.Printf("[WARN] unable to estimate the monthly cost of %s: %s", d.Id(), err)
+		estimate = ""
+	}
+	if err = d.Set("estimated_monthly_cost_usd", estimate); err != nil {
+		return diag.Errorf("unable to set estimated monthly cost field in schema: %s", err)
+	}
+
 	allocatedStaticIps, err := CurrentlyAllocatedStaticIps(ctx, projectName, serviceName, m)
 	if err != nil {
 		return diag.Errorf("unable to currently allocated static ips: %s", err)
@@ -364,7 +393,7 @@ func ResourceServiceRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.Errorf("unable to get service tags: %s", err)
 	}
 
-	if err := d.Set("tag", SetTagsTerraformProperties(t.Tags)); err != nil {
+	if err := SetTags(d, m, t.Tags); err != nil {
 		return diag.Errorf("unable to set tag's in schema: %s", err)
 	}
 
@@ -372,7 +401,7 @@ func ResourceServiceRead(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	serviceType := d.Get("service_type").(string)
 	project := d.Get("project").(string)
@@ -385,7 +414,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interf
 		diskSpace = ConvertToDiskSpaceMB(ds.(string))
 	} else {
 		// get service plan specific defaults
-		servicePlanParams, err := GetServicePlanParametersFromSchema(ctx, client, d)
+		servicePlanParams, err := GetServicePlanParametersFromSchema(ctx, m, d)
 		if err != nil {
 			return diag.Errorf("error getting service default plan parameters: %s", err)
 		}
@@ -402,13 +431,15 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.Errorf("error getting project VPC ID: %s", err)
 	}
 
-	cuc, err := apiconvert.ToAPI(userconfig.ServiceTypes, serviceType, d)
+	cuc, err := serviceUserConfigToAPI(d, serviceType)
 	if err != nil {
 		return diag.Errorf(
 			"error converting user config options for service type %s to API format: %s", serviceType, err,
 		)
 	}
 
+	cuc = applyRestoreFrom(d, cuc)
+
 	_, err = client.Services.Create(
 		project,
 		aiven.CreateServiceRequest{
@@ -429,6 +460,8 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.Errorf("error creating a service: %s", err)
 	}
 
+	InvalidateServiceList(m, project)
+
 	// Create already takes care of static ip associations, no need to explictely associate them here
 
 	s, err := WaitForServiceCreation(ctx, d, m)
@@ -437,7 +470,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	_, err = client.ServiceTags.Set(project, d.Get("service_name").(string), aiven.ServiceTagsRequest{
-		Tags: GetTagsFromSchema(d),
+		Tags: GetTagsFromSchema(d, m),
 	})
 	if err != nil {
 		return diag.Errorf("error setting service tags: %s", err)
@@ -445,11 +478,38 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	d.SetId(BuildResourceID(project, s.Name))
 
+	if err := applyMaintenanceUpdates(ctx, d, m, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error applying maintenance updates: %s", err)
+	}
+
+	// Services are created powered on, so powering off is a separate update
+	if !d.Get("powered").(bool) {
+		if err := powerOffService(ctx, d, m); err != nil {
+			return diag.Errorf("error powering off the service: %s", err)
+		}
+	}
+
 	return ResourceServiceRead(ctx, d, m)
 }
 
 func ResourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
+
+	// wait_for_state and readiness_checks only affect how the provider waits, and maintenance updates are applied on
+	// their own, there is nothing else to update
+	if !d.HasChangesExcept(
+		"wait_for_state",
+		"readiness_checks",
+		"apply_maintenance_updates",
+		"pending_maintenance_updates",
+		"estimated_monthly_cost_usd",
+	) {
+		if err := applyMaintenanceUpdates(ctx, d, m, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error applying maintenance updates: %s", err)
+		}
+
+		return ResourceServiceRead(ctx, d, m)
+	}
 
 	var karapace *bool
 	if v := d.Get("karapace"); d.HasChange("karapace") && v != nil {
@@ -460,7 +520,7 @@ func ResourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	// On service update, we send a default disc space value for a common
 	// if the TF user does not specify it
-	diskSpace, err := getDefaultDiskSpaceIfNotSet(ctx, d, client)
+	diskSpace, err := getDefaultDiskSpaceIfNotSet(ctx, d, m)
 	if err != nil {
 		return diag.Errorf("error getting default disc space: %s", err)
 	}
@@ -490,7 +550,7 @@ func ResourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	st := d.Get("service_type").(string)
 
-	cuc, err := apiconvert.ToAPI(userconfig.ServiceTypes, st, d)
+	cuc, err := serviceUserConfigToAPI(d, st)
 	if err != nil {
 		return diag.Errorf(
 			"error converting user config options for service type %s to API format: %s", st, err,
@@ -505,7 +565,7 @@ func ResourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			Plan:                  d.Get("plan").(string),
 			MaintenanceWindow:     GetMaintenanceWindow(d),
 			ProjectVPCID:          vpcID,
-			Powered:               true,
+			Powered:               d.Get("powered").(bool),
 			TerminationProtection: d.Get("termination_protection").(bool),
 			DiskSpaceMB:           diskSpace,
 			Karapace:              karapace,
@@ -515,10 +575,20 @@ func ResourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.Errorf("error updating (%s) service: %s", serviceName, err)
 	}
 
+	InvalidateServiceList(m, projectName)
+
 	if _, err = WaitForServiceUpdate(ctx, d, m); err != nil {
 		return diag.Errorf("error waiting for service (%s) update: %s", serviceName, err)
 	}
 
+	if err := updateServiceIntegrations(d, m, projectName, serviceName); err != nil {
+		return diag.Errorf("error updating service (%s) integrations: %s", serviceName, err)
+	}
+
+	if err := promoteReadReplica(ctx, d, m, projectName, serviceName); err != nil {
+		return diag.Errorf("error promoting service (%s) to primary: %s", serviceName, err)
+	}
+
 	if len(dis) > 0 {
 		for _, dip := range dis {
 			if err := client.StaticIPs.Dissociate(projectName, dip); err != nil {
@@ -531,20 +601,24 @@ func ResourceServiceUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	_, err = client.ServiceTags.Set(projectName, serviceName, aiven.ServiceTagsRequest{
-		Tags: GetTagsFromSchema(d),
+		Tags: GetTagsFromSchema(d, m),
 	})
 	if err != nil {
 		return diag.Errorf("error setting service tags: %s", err)
 	}
 
+	if err := applyMaintenanceUpdates(ctx, d, m, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error applying maintenance updates: %s", err)
+	}
+
 	return ResourceServiceRead(ctx, d, m)
 }
 
-func getDefaultDiskSpaceIfNotSet(ctx context.Context, d *schema.ResourceData, client *aiven.Client) (int, error) {
+func getDefaultDiskSpaceIfNotSet(ctx context.Context, d *schema.ResourceData, m interface{}) (int, error) {
 	var diskSpace int
 	if ds, ok := d.GetOk("disk_space"); !ok {
 		// get service plan specific defaults
-		servicePlanParams, err := GetServicePlanParametersFromSchema(ctx, client, d)
+		servicePlanParams, err := GetServicePlanParametersFromSchema(ctx, m, d)
 		if err != nil {
 			if aiven.IsNotFound(err) {
 				return 0, nil
@@ -566,7 +640,7 @@ func getDefaultDiskSpaceIfNotSet(ctx context.Context, d *schema.ResourceData, cl
 }
 
 func ResourceServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, err := SplitResourceID2(d.Id())
 	if err != nil {
@@ -577,6 +651,8 @@ func ResourceServiceDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.Errorf("error deleting a service: %s", err)
 	}
 
+	InvalidateServiceList(m, projectName)
+
 	// Delete already takes care of static IPs disassociation; no need to explicitly disassociate them here
 
 	if err := WaitForDeletion(ctx, d, m); err != nil {
@@ -590,6 +666,8 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 	s *aiven.Service,
 	servicePlanParams PlanParameters,
 	project string,
+	rawConnectionInfo map[string]interface{},
+	readFullUserConfig bool,
 ) error {
 	serviceType := d.Get("service_type").(string)
 	if _, ok := d.GetOk("service_type"); !ok {
@@ -614,12 +692,21 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 	if err := d.Set("termination_protection", s.TerminationProtection); err != nil {
 		return err
 	}
+	if err := d.Set("powered", s.Powered); err != nil {
+		return err
+	}
+	if err := d.Set("service_integrations", FlattenServiceIntegrations(d.Get("service_integrations").([]interface{}), s)); err != nil {
+		return err
+	}
 	if err := d.Set("maintenance_window_dow", s.MaintenanceWindow.DayOfWeek); err != nil {
 		return err
 	}
 	if err := d.Set("maintenance_window_time", s.MaintenanceWindow.TimeOfDay); err != nil {
 		return err
 	}
+	if err := d.Set("pending_maintenance_updates", FlattenMaintenanceUpdates(s.MaintenanceWindow.Updates)); err != nil {
+		return err
+	}
 	if _, ok := d.GetOk("disk_space"); ok && s.DiskSpaceMB != 0 {
 		if err := d.Set("disk_space", HumanReadableByteSize(s.DiskSpaceMB*units.MiB)); err != nil {
 			return err
@@ -656,39 +743,12 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 		}
 	}
 
-	oldUserConfig, err := unmarshalUserConfig(d.Get(serviceType + "_user_config"))
-	if err != nil {
-		return err
-	}
-
-	newUserConfig, err := apiconvert.FromAPI(userconfig.ServiceTypes, serviceType, s.UserConfig)
-	if err != nil {
-		return err
-	}
-
-	// Apply in-place user config mutations.
-	if len(oldUserConfig)*len(newUserConfig) != 0 {
-		oldUserConfigFirst := oldUserConfig[0]
-
-		newUserConfigFirst := newUserConfig[0]
-
-		// TODO: Remove when the remote schema in Aiven begins to contain information about sensitive fields.
-		copySensitiveFields(oldUserConfigFirst, newUserConfigFirst)
-
-		// TODO: Remove when we no longer need to support the deprecated `ip_filter` field.
-		if _, exists := d.GetOk(serviceType + "_user_config.0.ip_filter_string"); exists {
-			stringSuffixForIPFilters(newUserConfigFirst)
-		}
-
-		if _, exists := d.GetOk(serviceType + "_user_config.0.rules.0.mapping.0.namespaces_string"); exists {
-			stringSuffixForNamespaces(newUserConfigFirst)
+	if isGenericService(d) {
+		if err := setRawUserConfig(d, withoutRestoreFrom(d, s.UserConfig), readFullUserConfig); err != nil {
+			return err
 		}
-
-		normalizeIPFilter(oldUserConfigFirst, newUserConfigFirst)
-	}
-
-	if err := d.Set(serviceType+"_user_config", newUserConfig); err != nil {
-		return fmt.Errorf("cannot set `%s_user_config` : %s; Please make sure that all Aiven services have unique s names", serviceType, err)
+	} else if err := setServiceUserConfig(d, serviceType, withoutRestoreFrom(d, s.UserConfig)); err != nil {
+		return err
 	}
 
 	params := s.URIParams
@@ -732,7 +792,52 @@ func copyServicePropertiesFromAPIResponseToTerraform(
 		return fmt.Errorf("cannot set `components` : %s", err)
 	}
 
-	return copyConnectionInfoFromAPIResponseToTerraform(d, serviceType, s.ConnectionInfo, s.Metadata)
+	// the connection info of the generic service is in its components
+	if isGenericService(d) {
+		return nil
+	}
+
+	return copyConnectionInfoFromAPIResponseToTerraform(d, serviceType, s.ConnectionInfo, rawConnectionInfo, s.Metadata)
+}
+
+// setServiceUserConfig sets the user config block of the service type from the user config returned by the API.
+func setServiceUserConfig(d *schema.ResourceData, serviceType string, apiUserConfig map[string]interface{}) error {
+	oldUserConfig, err := unmarshalUserConfig(d.Get(serviceType + "_user_config"))
+	if err != nil {
+		return err
+	}
+
+	newUserConfig, err := apiconvert.FromAPI(userconfig.ServiceTypes, serviceType, apiUserConfig)
+	if err != nil {
+		return err
+	}
+
+	// Apply in-place user config mutations.
+	if len(oldUserConfig)*len(newUserConfig) != 0 {
+		oldUserConfigFirst := oldUserConfig[0]
+
+		newUserConfigFirst := newUserConfig[0]
+
+		// TODO: Remove when the remote schema in Aiven begins to contain information about sensitive fields.
+		copySensitiveFields(oldUserConfigFirst, newUserConfigFirst)
+
+		// TODO: Remove when we no longer need to support the deprecated `ip_filter` field.
+		if _, exists := d.GetOk(serviceType + "_user_config.0.ip_filter_string"); exists {
+			stringSuffixForIPFilters(newUserConfigFirst)
+		}
+
+		if _, exists := d.GetOk(serviceType + "_user_config.0.rules.0.mapping.0.namespaces_string"); exists {
+			stringSuffixForNamespaces(newUserConfigFirst)
+		}
+
+		normalizeIPFilter(oldUserConfigFirst, newUserConfigFirst)
+	}
+
+	if err := d.Set(serviceType+"_user_config", newUserConfig); err != nil {
+		return fmt.Errorf("cannot set `%s_user_config` : %s; Please make sure that all Aiven services have unique s names", serviceType, err)
+	}
+
+	return nil
 }
 
 func FlattenServiceComponents(r *aiven.Service) []map[string]interface{} {
@@ -756,63 +861,6 @@ func FlattenServiceComponents(r *aiven.Service) []map[string]interface{} {
 	return components
 }
 
-func copyConnectionInfoFromAPIResponseToTerraform(
-	d *schema.ResourceData,
-	serviceType string,
-	connectionInfo aiven.ConnectionInfo,
-	metadata interface{},
-) error {
-	props := make(map[string]interface{})
-
-	switch serviceType {
-	case "cassandra":
-	case "opensearch":
-		props["opensearch_dashboards_uri"] = connectionInfo.OpensearchDashboardsURI
-	case "elasticsearch":
-		props["kibana_uri"] = connectionInfo.KibanaURI
-	case "grafana":
-	case "influxdb":
-		props["database_name"] = connectionInfo.InfluxDBDatabaseName
-	case "kafka":
-		props["access_cert"] = connectionInfo.KafkaAccessCert
-		props["access_key"] = connectionInfo.KafkaAccessKey
-		props["connect_uri"] = connectionInfo.KafkaConnectURI
-		props["rest_uri"] = connectionInfo.KafkaRestURI
-		props["schema_registry_uri"] = connectionInfo.SchemaRegistryURI
-	case "kafka_connect":
-	case "mysql":
-	case "pg":
-		if connectionInfo.PostgresURIs != nil && len(connectionInfo.PostgresURIs) > 0 {
-			props["uri"] = connectionInfo.PostgresURIs[0]
-		}
-		if connectionInfo.PostgresParams != nil && len(connectionInfo.PostgresParams) > 0 {
-			params := connectionInfo.PostgresParams[0]
-			props["dbname"] = params.DatabaseName
-			props["host"] = params.Host
-			props["password"] = params.Password
-			port, err := strconv.ParseInt(params.Port, 10, 32)
-			if err == nil {
-				props["port"] = int(port)
-			}
-			props["sslmode"] = params.SSLMode
-			props["user"] = params.User
-		}
-		props["replica_uri"] = connectionInfo.PostgresReplicaURI
-		props["max_connections"] = metadata.(map[string]interface{})["max_connections"]
-	case "clickhouse":
-	case "redis":
-	case "flink":
-		props["host_ports"] = connectionInfo.FlinkHostPorts
-	case "kafka_mirrormaker":
-	case "m3db":
-	case "m3aggregator":
-	default:
-		panic(fmt.Sprintf("Unsupported service type %v", serviceType))
-	}
-
-	return d.Set(serviceType, []map[string]interface{}{props})
-}
-
 // IsUnknownRole checks if the database returned an error because of an unknown role
 // to make deletions idempotent
 func IsUnknownRole(err error) bool {
@@ -837,20 +885,26 @@ func ResourceReadHandleNotFound(err error, d *schema.ResourceData) error {
 }
 
 func DatasourceServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
-
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
 	d.SetId(BuildResourceID(projectName, serviceName))
 
-	services, err := client.Services.List(projectName)
-	if err != nil {
-		return diag.Errorf("error getting a list of services: %s", err)
-	}
+	// The cached list may predate the service, e.g. when it is created in the same apply, so the list is
+	// fetched again before reporting the service as missing.
+	for _, refresh := range []bool{false, true} {
+		if refresh {
+			InvalidateServiceList(m, projectName)
+		}
 
-	for _, service := range services {
-		if service.Name == serviceName {
-			return ResourceServiceRead(ctx, d, m)
+		services, err := ListServices(m, projectName)
+		if err != nil {
+			return diag.Errorf("error getting a list of services: %s", err)
+		}
+
+		for _, service := range services {
+			if service.Name == serviceName {
+				return resourceServiceRead(ctx, d, m, true)
+			}
 		}
 	}
 
@@ -0,0 +1,154 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+const (
+	// serviceLogLines is the number of service log lines added to the errors of the service waiters.
+	serviceLogLines = 20
+
+	// serviceDiagnosticsTimeout is the timeout of the API calls gathering the service diagnostics, the context of
+	// the waiter may be done already.
+	serviceDiagnosticsTimeout = 30 * time.Second
+)
+
+// serviceLogEntry is a line of the service logs.
+type serviceLogEntry struct {
+	Msg  string `json:"msg"`
+	Time string `json:"time"`
+	Unit string `json:"unit"`
+}
+
+// serviceLogsRequest is the request of the service logs API.
+type serviceLogsRequest struct {
+	Limit     int    `json:"limit"`
+	SortOrder string `json:"sort_order"`
+}
+
+// serviceLogsResponse is the response of the service logs API.
+type serviceLogsResponse struct {
+	Logs []serviceLogEntry `json:"logs"`
+}
+
+// withServiceDiagnostics adds the node states, the pending maintenance updates and the last service log lines of
+// the service to err, so that a failed wait can be troubleshot without the console. The diagnostics that cannot be
+// fetched are left out.
+func withServiceDiagnostics(err error, m interface{}, projectName, serviceName string) error {
+	client := m.(*common.ProviderMeta).Client
+
+	service, serr := client.Services.Get(projectName, serviceName)
+	if serr != nil {
+		log.Printf("[DEBUG] unable to fetch service %s/%s diagnostics: %s", projectName, serviceName, serr)
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), serviceDiagnosticsTimeout)
+	defer cancel()
+
+	var logs serviceLogsResponse
+
+	if lerr := common.DoAPIRequest(
+		ctx,
+		client,
+		http.MethodPost,
+		fmt.Sprintf("/v1/project/%s/service/%s/logs", url.PathEscape(projectName), url.PathEscape(serviceName)),
+		serviceLogsRequest{Limit: serviceLogLines, SortOrder: "desc"},
+		&logs,
+	); lerr != nil {
+		log.Printf("[DEBUG] unable to fetch service %s/%s logs: %s", projectName, serviceName, lerr)
+	}
+
+	return fmt.Errorf("%w\n\n%s", err, formatServiceDiagnostics(service, logs.Logs))
+}
+
+// formatServiceDiagnostics describes the state of the service, its nodes and pending maintenance updates, and the
+// given log lines, which are sorted from the newest to the oldest.
+func formatServiceDiagnostics(service *aiven.Service, logs []serviceLogEntry) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Service %s is %s.", service.Name, service.State)
+
+	if len(service.NodeStates) > 0 {
+		b.WriteString("\n\nNode states:")
+
+		for _, n := range service.NodeStates {
+			fmt.Fprintf(&b, "\n  %s", formatNodeState(n))
+		}
+	}
+
+	if len(service.MaintenanceWindow.Updates) > 0 {
+		b.WriteString("\n\nPending maintenance updates:")
+
+		for _, u := range service.MaintenanceWindow.Updates {
+			fmt.Fprintf(&b, "\n  %s", u.Description)
+
+			if u.Deadline != nil {
+				fmt.Fprintf(&b, " (deadline %s)", *u.Deadline)
+			}
+		}
+	}
+
+	if len(logs) > 0 {
+		fmt.Fprintf(&b, "\n\nLast %d service log lines:", len(logs))
+
+		for i := len(logs) - 1; i >= 0; i-- {
+			l := logs[i]
+			fmt.Fprintf(&b, "\n  %s %s: %s", l.Time, l.Unit, l.Msg)
+		}
+	}
+
+	return b.String()
+}
+
+// formatNodeState describes the state of the node and the progress of its current phase.
+func formatNodeState(n *aiven.NodeState) string {
+	s := fmt.Sprintf("%s (%s): %s", n.Name, n.Role, n.State)
+
+	for _, p := range n.ProgressUpdates {
+		if p.Completed {
+			continue
+		}
+
+		s += fmt.Sprintf(", %s", p.Phase)
+
+		if p.Max > 0 {
+			s += fmt.Sprintf(" %d/%d %s", p.Current, p.Max, p.Unit)
+		}
+	}
+
+	return s
+}
+
+// serviceProgressLogger logs the progress of a service wait as warnings, each time it changes, so that long waits
+// can be followed in the provider logs.
+type serviceProgressLogger struct {
+	last string
+}
+
+// log logs the progress of the service, if it changed since the last call.
+func (l *serviceProgressLogger) log(service *aiven.Service) {
+	progress := service.State
+
+	for _, n := range service.NodeStates {
+		progress += "; " + formatNodeState(n)
+	}
+
+	if progress == l.last {
+		return
+	}
+
+	l.last = progress
+
+	log.Printf("[WARN] waiting for service %s: %s", service.Name, progress)
+}
@@ -0,0 +1,86 @@
+package schemautil
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestFormatServiceDiagnostics(t *testing.T) {
+	deadline := "2023-07-01T00:00:00Z"
+
+	service := &aiven.Service{
+		Name:  "my-pg",
+		State: "REBUILDING",
+		NodeStates: []*aiven.NodeState{
+			{
+				Name:  "my-pg-1",
+				Role:  "master",
+				State: "syncing_data",
+				ProgressUpdates: []aiven.ProgressUpdate{
+					{Phase: "prepare", Completed: true},
+					{Phase: "stream", Current: 10, Max: 40, Unit: "bytes_uncompressed"},
+				},
+			},
+			{Name: "my-pg-2", Role: "standby", State: "running"},
+		},
+		MaintenanceWindow: aiven.MaintenanceWindow{
+			Updates: []*aiven.MaintenanceUpdate{{Description: "Upgrade PostgreSQL to 15.3", Deadline: &deadline}},
+		},
+	}
+
+	logs := []serviceLogEntry{
+		{Time: "2023-06-30T10:00:02Z", Unit: "pglookout", Msg: "newest"},
+		{Time: "2023-06-30T10:00:01Z", Unit: "postgresql", Msg: "oldest"},
+	}
+
+	assert.Equal(t, `Service my-pg is REBUILDING.
+
+Node states:
+  my-pg-1 (master): syncing_data, stream 10/40 bytes_uncompressed
+  my-pg-2 (standby): running
+
+Pending maintenance updates:
+  Upgrade PostgreSQL to 15.3 (deadline 2023-07-01T00:00:00Z)
+
+Last 2 service log lines:
+  2023-06-30T10:00:01Z postgresql: oldest
+  2023-06-30T10:00:02Z pglookout: newest`, formatServiceDiagnostics(service, logs))
+}
+
+func TestWithServiceDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/project/my-project/service/my-pg":
+			_, _ = w.Write([]byte(`{"service": {"service_name": "my-pg", "state": "REBUILDING"}}`))
+		case "/v1/project/my-project/service/my-pg/logs":
+			_, _ = w.Write([]byte(`{"logs": [{"msg": "waiting for disk", "time": "t1", "unit": "pg"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := common.NewCustomAivenClient("token", "", "", common.ClientOptions{APIURL: server.URL})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	meta := common.NewProviderMeta(client, &common.ProviderConfig{})
+	timeout := errors.New("timeout while waiting for state to become 'RUNNING'")
+
+	err = withServiceDiagnostics(timeout, meta, "my-project", "my-pg")
+	assert.ErrorIs(t, err, timeout)
+	assert.Contains(t, err.Error(), "Service my-pg is REBUILDING.")
+	assert.Contains(t, err.Error(), "t1 pg: waiting for disk")
+
+	// the diagnostics of a missing service are left out
+	err = withServiceDiagnostics(timeout, meta, "my-project", "missing")
+	assert.Equal(t, timeout, err)
+}
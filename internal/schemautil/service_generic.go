@@ -0,0 +1,137 @@
+package schemautil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/apiconvert"
+)
+
+// isGenericService returns true if d belongs to the generic aiven_service resource or data source, which has a raw
+// JSON user_config attribute instead of the per service type blocks.
+func isGenericService(d ResourceStateOrResourceDiff) bool {
+	_, ok := d.Get("user_config").(string)
+	return ok
+}
+
+// serviceUserConfigToAPI returns the user config of the service of d in the API format.
+func serviceUserConfigToAPI(d *schema.ResourceData, serviceType string) (map[string]interface{}, error) {
+	if !isGenericService(d) {
+		return apiconvert.ToAPI(userconfig.ServiceTypes, serviceType, d)
+	}
+
+	return decodeRawUserConfig(d.Get("user_config").(string))
+}
+
+// decodeRawUserConfig decodes a raw JSON user config, an empty string is no user config.
+func decodeRawUserConfig(raw string) (map[string]interface{}, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, fmt.Errorf("user_config is not a JSON object: %w", err)
+	}
+
+	return v, nil
+}
+
+// setRawUserConfig sets the user_config attribute of d to the user config returned by the API. Only the properties
+// of the current value are kept, since the API returns the defaults of the properties that were not set, unless
+// readFull is set, e.g. on import or in data sources.
+func setRawUserConfig(d *schema.ResourceData, apiUserConfig map[string]interface{}, readFull bool) error {
+	current, err := decodeRawUserConfig(d.Get("user_config").(string))
+	if err != nil {
+		return err
+	}
+
+	v := apiUserConfig
+	if !readFull {
+		v = filterUserConfig(apiUserConfig, current)
+	}
+
+	if len(v) == 0 {
+		return d.Set("user_config", "")
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("user_config", string(b))
+}
+
+// ImportGenericServiceState reads all the options of the user config of the imported generic service, since there is
+// no configuration yet to tell which of them are set.
+func ImportGenericServiceState(
+	_ context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) ([]*schema.ResourceData, error) {
+	client := m.(*common.ProviderMeta).Client
+
+	projectName, serviceName, err := SplitResourceID2(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := client.Services.Get(projectName, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setRawUserConfig(d, s.UserConfig, true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// filterUserConfig returns the properties of v that are also in keep, nested objects are filtered the same way.
+func filterUserConfig(v, keep map[string]interface{}) map[string]interface{} {
+	r := make(map[string]interface{})
+
+	for k, kv := range keep {
+		av, ok := v[k]
+		if !ok {
+			continue
+		}
+
+		nestedKeep, keepIsMap := kv.(map[string]interface{})
+		nested, isMap := av.(map[string]interface{})
+
+		if keepIsMap && isMap {
+			r[k] = filterUserConfig(nested, nestedKeep)
+			continue
+		}
+
+		r[k] = av
+	}
+
+	return r
+}
+
+// CustomizeDiffGenericServiceUserConfig checks the raw user_config against the schema of the service type.
+func CustomizeDiffGenericServiceUserConfig(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("user_config") || !d.NewValueKnown("service_type") {
+		return nil
+	}
+
+	v, err := decodeRawUserConfig(d.Get("user_config").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := userconfig.ValidateRaw(userconfig.ServiceTypes, d.Get("service_type").(string), v); err != nil {
+		return fmt.Errorf("invalid user_config: %w", err)
+	}
+
+	return nil
+}
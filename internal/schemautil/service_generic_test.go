@@ -0,0 +1,109 @@
+package schemautil
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestFilterUserConfig(t *testing.T) {
+	api := map[string]interface{}{
+		"pg_version": "15",
+		"ip_filter":  []interface{}{"0.0.0.0/0"},
+		"pg": map[string]interface{}{
+			"idle_in_transaction_session_timeout": float64(900),
+			"log_min_duration_statement":          float64(-1),
+		},
+	}
+
+	keep := map[string]interface{}{
+		"pg_version": "14",
+		"pg":         map[string]interface{}{"idle_in_transaction_session_timeout": float64(600)},
+		"static_ips": true,
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"pg_version": "15",
+		"pg":         map[string]interface{}{"idle_in_transaction_session_timeout": float64(900)},
+	}, filterUserConfig(api, keep))
+}
+
+func TestSetRawUserConfig(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"user_config": {Type: schema.TypeString, Optional: true},
+	}
+
+	api := map[string]interface{}{"pg_version": "15", "ip_filter": []interface{}{"0.0.0.0/0"}}
+
+	// the defaults are not read when there is no current value
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	assert.True(t, isGenericService(d))
+	assert.NoError(t, setRawUserConfig(d, api, false))
+	assert.Equal(t, "", d.Get("user_config"))
+
+	// all the options are read on import and in data sources
+	assert.NoError(t, setRawUserConfig(d, api, true))
+	assert.JSONEq(t, `{"pg_version": "15", "ip_filter": ["0.0.0.0/0"]}`, d.Get("user_config").(string))
+
+	d = schema.TestResourceDataRaw(t, s, map[string]interface{}{"user_config": `{"pg_version": "14"}`})
+	assert.NoError(t, setRawUserConfig(d, api, false))
+	assert.JSONEq(t, `{"pg_version": "15"}`, d.Get("user_config").(string))
+
+	assert.False(t, isGenericService(schema.TestResourceDataRaw(t, ServiceCommonSchema(), map[string]interface{}{})))
+}
+
+func TestResourceServiceReadWithoutUserConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/project/my-project/service/my-pg":
+			_, _ = w.Write([]byte(`{"service": {"service_name": "my-pg", "service_type": "pg", "plan": "startup-4", ` +
+				`"state": "RUNNING", "user_config": {"pg_version": "15", "ip_filter": ["0.0.0.0/0"]}}}`))
+		case "/v1/project/my-project/service-types/pg/plans/startup-4":
+			_, _ = w.Write([]byte(`{"disk_space_mb": 81920}`))
+		case "/v1/project/my-project/static-ips":
+			_, _ = w.Write([]byte(`{"static_ips": []}`))
+		case "/v1/project/my-project/service/my-pg/tags":
+			_, _ = w.Write([]byte(`{"tags": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := common.NewCustomAivenClient("token", "", "", common.ClientOptions{APIURL: server.URL})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	meta := common.NewProviderMeta(client, &common.ProviderConfig{})
+
+	// the API defaults are not written to the state of a service created without user_config
+	d := schema.TestResourceDataRaw(t, aivenServiceTestSchema(), map[string]interface{}{
+		"project":      "my-project",
+		"service_name": "my-pg",
+		"service_type": "pg",
+	})
+	d.SetId("my-project/my-pg")
+
+	assert.False(t, ResourceServiceRead(context.Background(), d, meta).HasError())
+	assert.Equal(t, "", d.Get("user_config"))
+	assert.Equal(t, "RUNNING", d.Get("state"))
+
+	// the data source reads all of them
+	assert.False(t, resourceServiceRead(context.Background(), d, meta, true).HasError())
+	assert.JSONEq(t, `{"pg_version": "15", "ip_filter": ["0.0.0.0/0"]}`, d.Get("user_config").(string))
+}
+
+func aivenServiceTestSchema() map[string]*schema.Schema {
+	s := ServiceCommonSchema()
+	s["service_type"] = &schema.Schema{Type: schema.TypeString, Required: true}
+	s["user_config"] = &schema.Schema{Type: schema.TypeString, Optional: true}
+
+	return s
+}
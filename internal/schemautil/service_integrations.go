@@ -0,0 +1,134 @@
+package schemautil
+
+import (
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+// serviceIntegrationKey identifies an integration of the service_integrations attribute, the service it belongs to
+// is its destination.
+type serviceIntegrationKey struct {
+	sourceService   string
+	integrationType string
+}
+
+// serviceIntegrationKeys returns the keys of the integrations of a service_integrations value.
+func serviceIntegrationKeys(integrations []interface{}) []serviceIntegrationKey {
+	keys := make([]serviceIntegrationKey, 0, len(integrations))
+
+	for _, i := range integrations {
+		v, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		keys = append(keys, serviceIntegrationKey{
+			sourceService:   v["source_service_name"].(string),
+			integrationType: v["integration_type"].(string),
+		})
+	}
+
+	return keys
+}
+
+// destinationIntegrations returns the integrations of the list the service is the destination of, by key.
+func destinationIntegrations(serviceName string, integrations []*aiven.ServiceIntegration) map[serviceIntegrationKey]*aiven.ServiceIntegration {
+	r := make(map[serviceIntegrationKey]*aiven.ServiceIntegration)
+
+	for _, i := range integrations {
+		if i.DestinationService == nil || *i.DestinationService != serviceName || i.SourceService == nil {
+			continue
+		}
+
+		r[serviceIntegrationKey{sourceService: *i.SourceService, integrationType: i.IntegrationType}] = i
+	}
+
+	return r
+}
+
+// FlattenServiceIntegrations returns the service_integrations value of the service: the integrations of the current
+// value that the service still is the destination of, so that the ones deleted outside of Terraform show as drift.
+// Integrations managed otherwise, e.g. with the aiven_service_integration resource or in the console, are left out.
+func FlattenServiceIntegrations(current []interface{}, s *aiven.Service) []map[string]interface{} {
+	existing := destinationIntegrations(s.Name, s.Integrations)
+
+	r := make([]map[string]interface{}, 0, len(current))
+
+	for _, k := range serviceIntegrationKeys(current) {
+		if _, ok := existing[k]; !ok {
+			continue
+		}
+
+		r = append(r, map[string]interface{}{
+			"source_service_name": k.sourceService,
+			"integration_type":    k.integrationType,
+		})
+	}
+
+	return r
+}
+
+// updateServiceIntegrations deletes the integrations removed from service_integrations and creates the added ones.
+// Only the integrations created through service_integrations are ever deleted: adding an integration that already
+// exists, i.e. one managed otherwise, is an error.
+func updateServiceIntegrations(d *schema.ResourceData, m interface{}, projectName, serviceName string) error {
+	if !d.HasChange("service_integrations") {
+		return nil
+	}
+
+	client := m.(*common.ProviderMeta).Client
+
+	o, n := d.GetChange("service_integrations")
+	oldKeys := serviceIntegrationKeys(o.([]interface{}))
+	newKeys := serviceIntegrationKeys(n.([]interface{}))
+
+	integrations, err := client.ServiceIntegrations.List(projectName, serviceName)
+	if err != nil {
+		return fmt.Errorf("unable to list the service integrations: %w", err)
+	}
+
+	existing := destinationIntegrations(serviceName, integrations)
+
+	for _, k := range oldKeys {
+		i, ok := existing[k]
+		if !ok || slices.Contains(newKeys, k) {
+			continue
+		}
+
+		if err := client.ServiceIntegrations.Delete(projectName, i.ServiceIntegrationID); err != nil && !aiven.IsNotFound(err) {
+			return fmt.Errorf("unable to delete the %s integration from %s: %w", k.integrationType, k.sourceService, err)
+		}
+	}
+
+	for _, k := range newKeys {
+		if _, ok := existing[k]; ok {
+			if slices.Contains(oldKeys, k) {
+				continue
+			}
+
+			return fmt.Errorf(
+				"the %s integration from %s already exists, it must be removed from service_integrations or deleted "+
+					"where it is managed",
+				k.integrationType, k.sourceService,
+			)
+		}
+
+		sourceService, destinationService := k.sourceService, serviceName
+
+		if _, err := client.ServiceIntegrations.Create(projectName, aiven.CreateServiceIntegrationRequest{
+			IntegrationType:    k.integrationType,
+			SourceService:      &sourceService,
+			DestinationService: &destinationService,
+			UserConfig:         make(map[string]interface{}),
+		}); err != nil {
+			return fmt.Errorf("unable to create the %s integration from %s: %w", k.integrationType, k.sourceService, err)
+		}
+	}
+
+	return nil
+}
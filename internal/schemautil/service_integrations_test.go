@@ -0,0 +1,147 @@
+package schemautil
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestFlattenServiceIntegrations(t *testing.T) {
+	replica, primary, other, metrics := "replica-pg", "primary-pg", "other-pg", "metrics-m3db"
+
+	s := &aiven.Service{
+		Name: replica,
+		Integrations: []*aiven.ServiceIntegration{
+			{IntegrationType: "logs", SourceService: &other, DestinationService: &replica},
+			{IntegrationType: "read_replica", SourceService: &primary, DestinationService: &replica},
+			{IntegrationType: "metrics", SourceService: &replica, DestinationService: &metrics},
+		},
+	}
+
+	current := []interface{}{
+		map[string]interface{}{"source_service_name": primary, "integration_type": "read_replica"},
+		map[string]interface{}{"source_service_name": other, "integration_type": "read_replica"},
+	}
+
+	// the removed integration is left out, and so are the one added outside of Terraform and the one the service is
+	// the source of
+	assert.Equal(t, []map[string]interface{}{
+		{"source_service_name": primary, "integration_type": "read_replica"},
+	}, FlattenServiceIntegrations(current, s))
+
+	// none of them is read without a current value, e.g. on import
+	assert.Empty(t, FlattenServiceIntegrations(nil, s))
+}
+
+func TestUpdateServiceIntegrations(t *testing.T) {
+	var deleted, created []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/project/my-project/service/my-pg/integration":
+			_, _ = w.Write([]byte(`{"service_integrations": [
+				{"service_integration_id": "1", "integration_type": "logs", "source_service": "logs-pg", "dest_service": "my-pg"},
+				{"service_integration_id": "2", "integration_type": "metrics", "source_service": "kept-pg", "dest_service": "my-pg"},
+				{"service_integration_id": "3", "integration_type": "metrics", "source_service": "my-pg", "dest_service": "m3db"},
+				{"service_integration_id": "5", "integration_type": "logs", "source_service": "other-pg", "dest_service": "my-pg"}
+			]}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/project/my-project/integration":
+			var req aiven.CreateServiceIntegrationRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			created = append(created, *req.SourceService+"/"+req.IntegrationType+"/"+*req.DestinationService)
+			_, _ = w.Write([]byte(`{"service_integration": {"service_integration_id": "4"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := common.NewCustomAivenClient("token", "", "", common.ClientOptions{APIURL: server.URL})
+	require.NoError(t, err)
+
+	meta := common.NewProviderMeta(client, &common.ProviderConfig{})
+
+	s := map[string]*schema.Schema{"service_integrations": ServiceCommonSchema()["service_integrations"]}
+	state := &terraform.InstanceState{
+		ID: "my-project/my-pg",
+		Attributes: map[string]string{
+			"id":                     "my-project/my-pg",
+			"service_integrations.#": "2",
+			"service_integrations.0.source_service_name": "logs-pg",
+			"service_integrations.0.integration_type":    "logs",
+			"service_integrations.1.source_service_name": "kept-pg",
+			"service_integrations.1.integration_type":    "metrics",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		integrations []interface{}
+		wantDeleted  []string
+		wantCreated  []string
+		wantErr      bool
+	}{
+		{
+			// the removed integration is deleted, the added one created and the others left as is
+			name: "changed",
+			integrations: []interface{}{
+				map[string]interface{}{"source_service_name": "kept-pg", "integration_type": "metrics"},
+				map[string]interface{}{"source_service_name": "new-pg", "integration_type": "logs"},
+			},
+			wantDeleted: []string{"/v1/project/my-project/integration/1"},
+			wantCreated: []string{"new-pg/logs/my-pg"},
+		},
+		{
+			// the integrations created through the attribute are deleted, the one managed otherwise is not
+			name:         "removed",
+			integrations: nil,
+			wantDeleted:  []string{"/v1/project/my-project/integration/1", "/v1/project/my-project/integration/2"},
+		},
+		{
+			// the integration managed otherwise is not taken over
+			name: "existing_added",
+			integrations: []interface{}{
+				map[string]interface{}{"source_service_name": "logs-pg", "integration_type": "logs"},
+				map[string]interface{}{"source_service_name": "kept-pg", "integration_type": "metrics"},
+				map[string]interface{}{"source_service_name": "other-pg", "integration_type": "logs"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted, created = nil, nil
+
+			raw := make(map[string]interface{})
+			if tt.integrations != nil {
+				raw["service_integrations"] = tt.integrations
+			}
+
+			diff, err := schema.InternalMap(s).Diff(
+				context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true,
+			)
+			require.NoError(t, err)
+
+			d, err := schema.InternalMap(s).Data(state, diff)
+			require.NoError(t, err)
+
+			err = updateServiceIntegrations(d, meta, "my-project", "my-pg")
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantDeleted, deleted)
+			assert.Equal(t, tt.wantCreated, created)
+		})
+	}
+}
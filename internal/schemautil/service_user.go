@@ -12,10 +12,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
 func ResourceServiceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -47,7 +49,7 @@ func ResourceServiceUserCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func ResourceServiceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, username, err := SplitResourceID3(d.Id())
 	if err != nil {
@@ -66,7 +68,7 @@ func ResourceServiceUserUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func ResourceServiceUserRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, username, err := SplitResourceID3(d.Id())
 	if err != nil {
@@ -87,7 +89,7 @@ func ResourceServiceUserRead(_ context.Context, d *schema.ResourceData, m interf
 }
 
 func ResourceServiceUserDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, username, err := SplitResourceID3(d.Id())
 	if err != nil {
@@ -103,7 +105,7 @@ func ResourceServiceUserDelete(_ context.Context, d *schema.ResourceData, m inte
 }
 
 func DatasourceServiceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
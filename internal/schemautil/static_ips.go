@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aiven/aiven-go-client"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
 const (
@@ -17,7 +17,7 @@ const (
 )
 
 func CurrentlyAllocatedStaticIps(_ context.Context, projectName, serviceName string, m interface{}) ([]string, error) {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	// special handling for static ips
 	staticIPListResponse, err := client.StaticIPs.List(projectName)
@@ -50,7 +50,7 @@ func staticIpsFromSchema(d *schema.ResourceData) []string {
 }
 
 func staticIpsFromAPI(_ context.Context, d *schema.ResourceData, m interface{}) ([]string, error) {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
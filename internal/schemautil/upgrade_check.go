@@ -0,0 +1,245 @@
+package schemautil
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+const (
+	// upgradeCheckTimeout is the timeout of the upgrade check tasks run at plan time.
+	upgradeCheckTimeout = 10 * time.Minute
+
+	// endOfLifeWarningPeriod is how long before its end of life a target version is warned about.
+	endOfLifeWarningPeriod = 180 * 24 * time.Hour
+)
+
+// upgradeCheckServiceTypes are the service types the upgrade_check service task is available for.
+var upgradeCheckServiceTypes = map[string]bool{
+	ServiceTypePG: true,
+}
+
+// serviceVersionChange returns the old and new major version of the service type in the user config of d, either is
+// empty when it is not set.
+func serviceVersionChange(d *schema.ResourceDiff, serviceType string) (oldVersion, newVersion string) {
+	key := serviceType + "_version"
+
+	if _, generic := d.Get("user_config").(string); generic {
+		o, n := d.GetChange("user_config")
+		oldConfig, _ := decodeRawUserConfig(o.(string))
+		newConfig, _ := decodeRawUserConfig(n.(string))
+		oldVersion, _ = oldConfig[key].(string)
+		newVersion, _ = newConfig[key].(string)
+	} else {
+		o, n := d.GetChange(serviceType + "_user_config.0." + key)
+		oldVersion, _ = o.(string)
+		newVersion, _ = n.(string)
+	}
+
+	return oldVersion, newVersion
+}
+
+// CustomizeDiffServiceVersionUpgrade checks the major version upgrades of existing services at plan time: the
+// upgrade check of the service type, if any, must pass. Target versions close to their end of life are warned about
+// in the plan. The current version is read from the API when the state has none.
+func CustomizeDiffServiceVersionUpgrade(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	serviceType, _ := d.Get("service_type").(string)
+
+	oldVersion, newVersion := serviceVersionChange(d, serviceType)
+	if newVersion == "" || oldVersion == newVersion {
+		return nil
+	}
+
+	projectName, serviceName, err := SplitResourceID2(d.Id())
+	if err != nil {
+		return err
+	}
+
+	oldVersion, err = currentServiceVersion(m, projectName, serviceName, serviceType, oldVersion)
+	if err != nil {
+		return err
+	}
+
+	if oldVersion == "" || oldVersion == newVersion {
+		return nil
+	}
+
+	if w := serviceVersionWarning(ctx, m, serviceType, newVersion); w != "" {
+		common.AddPlanWarning(ctx, w, "")
+	}
+
+	if !upgradeCheckServiceTypes[serviceType] {
+		return nil
+	}
+
+	return checkServiceVersionUpgrade(ctx, m, projectName, serviceName, oldVersion, newVersion)
+}
+
+// currentServiceVersion returns the major version of the service in the state, or the one returned by the API when
+// the state has none, e.g. when it was never set in the configuration.
+func currentServiceVersion(m interface{}, projectName, serviceName, serviceType, stateVersion string) (string, error) {
+	if stateVersion != "" {
+		return stateVersion, nil
+	}
+
+	s, err := m.(*common.ProviderMeta).Client.Services.Get(projectName, serviceName)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the service: %w", err)
+	}
+
+	v, _ := s.UserConfig[serviceType+"_version"].(string)
+
+	return v, nil
+}
+
+// checkServiceVersionUpgrade runs the upgrade check of the service to the target version, and returns its result
+// as an error when the upgrade is not possible.
+func checkServiceVersionUpgrade(
+	ctx context.Context,
+	m interface{},
+	projectName, serviceName, oldVersion, newVersion string,
+) error {
+	client := m.(*common.ProviderMeta).Client
+
+	t, err := client.ServiceTask.Create(projectName, serviceName, aiven.ServiceTaskRequest{
+		TargetVersion: newVersion,
+		TaskType:      "upgrade_check",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create the upgrade check task: %w", err)
+	}
+
+	task, err := waitForServiceTask(ctx, client, projectName, serviceName, t.Task.Id, upgradeCheckTimeout)
+	if err != nil {
+		return err
+	}
+
+	if !*task.Success {
+		return fmt.Errorf("unable to upgrade from version %s to %s: %s", oldVersion, newVersion, task.Result)
+	}
+
+	log.Printf("[DEBUG] upgrade check from version %s to %s result: %s", oldVersion, newVersion, task.Result)
+
+	return nil
+}
+
+// waitForServiceTask waits until the service task is done.
+// nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
+func waitForServiceTask(
+	ctx context.Context,
+	client *aiven.Client,
+	projectName, serviceName, taskID string,
+	timeout time.Duration,
+) (*aiven.ServiceTask, error) {
+	conf := &resource.StateChangeConf{
+		Pending:                   []string{"IN_PROGRESS"},
+		Target:                    []string{"DONE"},
+		Delay:                     2 * time.Second,
+		Timeout:                   timeout,
+		MinTimeout:                2 * time.Second,
+		ContinuousTargetOccurence: 1,
+		Refresh: func() (interface{}, string, error) {
+			t, err := client.ServiceTask.Get(projectName, serviceName, taskID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if t.Task.Success == nil {
+				return t, "IN_PROGRESS", nil
+			}
+
+			return t, "DONE", nil
+		},
+	}
+
+	r, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for the service task to be done: %w", err)
+	}
+
+	return &r.(*aiven.ServiceTaskResponse).Task, nil
+}
+
+// serviceVersion is a major version of a service type, as listed by the service versions API.
+type serviceVersion struct {
+	ServiceType             string  `json:"service_type"`
+	MajorVersion            string  `json:"major_version"`
+	State                   string  `json:"state"`
+	AivenEndOfLifeTime      *string `json:"aiven_end_of_life_time"`
+	EndOfLifeHelpArticleURL *string `json:"end_of_life_help_article_url"`
+}
+
+// serviceVersionsResponse is the response of the service versions API.
+type serviceVersionsResponse struct {
+	ServiceVersions []*serviceVersion `json:"service_versions"`
+}
+
+// getServiceVersions returns the major versions of all service types, they are fetched once per provider instance
+// of m.
+func getServiceVersions(ctx context.Context, m interface{}) ([]*serviceVersion, error) {
+	return common.CachedLookup(
+		common.GetLookupCache(m),
+		common.LookupKey("service_versions"),
+		func() ([]*serviceVersion, error) {
+			var rsp serviceVersionsResponse
+
+			err := common.DoAPIRequest(
+				ctx, m.(*common.ProviderMeta).Client, http.MethodGet, "/v1/service_versions", nil, &rsp,
+			)
+
+			return rsp.ServiceVersions, err
+		},
+	)
+}
+
+// serviceVersionWarning returns a warning if the version of the service type reaches its end of life soon, and an
+// empty string otherwise or when the versions cannot be fetched.
+func serviceVersionWarning(ctx context.Context, m interface{}, serviceType, version string) string {
+	versions, err := getServiceVersions(ctx, m)
+	if err != nil {
+		log.Printf("[DEBUG] unable to get the service versions: %s", err)
+		return ""
+	}
+
+	return endOfLifeWarning(versions, serviceType, version, time.Now())
+}
+
+// endOfLifeWarning returns a warning if the version of the service type reaches its end of life within
+// endOfLifeWarningPeriod of now, or has reached it already.
+func endOfLifeWarning(versions []*serviceVersion, serviceType, version string, now time.Time) string {
+	for _, v := range versions {
+		if v.ServiceType != serviceType || v.MajorVersion != version || v.AivenEndOfLifeTime == nil {
+			continue
+		}
+
+		eol, err := time.Parse(time.RFC3339, *v.AivenEndOfLifeTime)
+		if err != nil || now.Add(endOfLifeWarningPeriod).Before(eol) {
+			return ""
+		}
+
+		w := fmt.Sprintf("%s version %s reaches its end of life on %s", serviceType, version, eol.Format("2006-01-02"))
+		if !now.Before(eol) {
+			w = fmt.Sprintf("%s version %s reached its end of life on %s", serviceType, version, eol.Format("2006-01-02"))
+		}
+
+		if v.EndOfLifeHelpArticleURL != nil && *v.EndOfLifeHelpArticleURL != "" {
+			w += ", see " + *v.EndOfLifeHelpArticleURL
+		}
+
+		return w
+	}
+
+	return ""
+}
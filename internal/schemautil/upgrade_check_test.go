@@ -0,0 +1,85 @@
+package schemautil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+)
+
+func TestEndOfLifeWarning(t *testing.T) {
+	now := time.Date(2023, 7, 3, 0, 0, 0, 0, time.UTC)
+	soon, later, past := "2023-11-09T00:00:00Z", "2026-11-12T00:00:00Z", "2023-06-30T00:00:00Z"
+	article := "https://docs.aiven.io/docs/platform/reference/eol-for-major-versions"
+
+	versions := []*serviceVersion{
+		{ServiceType: "pg", MajorVersion: "11", AivenEndOfLifeTime: &soon, EndOfLifeHelpArticleURL: &article},
+		{ServiceType: "pg", MajorVersion: "15", AivenEndOfLifeTime: &later},
+		{ServiceType: "pg", MajorVersion: "16"},
+		{ServiceType: "mysql", MajorVersion: "5", AivenEndOfLifeTime: &past},
+	}
+
+	tests := []struct {
+		name        string
+		serviceType string
+		version     string
+		want        string
+	}{
+		{
+			name:        "end of life soon",
+			serviceType: "pg",
+			version:     "11",
+			want:        "pg version 11 reaches its end of life on 2023-11-09, see " + article,
+		},
+		{
+			name:        "end of life reached",
+			serviceType: "mysql",
+			version:     "5",
+			want:        "mysql version 5 reached its end of life on 2023-06-30",
+		},
+		{name: "end of life later", serviceType: "pg", version: "15"},
+		{name: "no end of life", serviceType: "pg", version: "16"},
+		{name: "unknown version", serviceType: "kafka", version: "3.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, endOfLifeWarning(versions, tt.serviceType, tt.version, now))
+		})
+	}
+}
+
+func TestCurrentServiceVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/project/my-project/service/my-pg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"service": {"service_name": "my-pg", "user_config": {"pg_version": "14"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := common.NewCustomAivenClient("token", "", "", common.ClientOptions{APIURL: server.URL})
+	require.NoError(t, err)
+
+	meta := common.NewProviderMeta(client, &common.ProviderConfig{})
+
+	// the version of the state is used when set
+	v, err := currentServiceVersion(meta, "my-project", "my-pg", "pg", "13")
+	require.NoError(t, err)
+	assert.Equal(t, "13", v)
+
+	// the version of the API is used otherwise
+	v, err = currentServiceVersion(meta, "my-project", "my-pg", "pg", "")
+	require.NoError(t, err)
+	assert.Equal(t, "14", v)
+
+	_, err = currentServiceVersion(meta, "my-project", "missing", "pg", "")
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package stateupgrader
 
 import (
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader/v0/cassandra"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader/v0/flink"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader/v0/grafana"
@@ -25,6 +26,16 @@ func Cassandra() []schema.StateUpgrader {
 	}
 }
 
+// DiskSpace returns the upgrader from the version of a service resource with the schema s, replacing the deprecated
+// disk_space with the equivalent additional_disk_space.
+func DiskSpace(version int, s map[string]*schema.Schema) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Type:    (&schema.Resource{Schema: s}).CoreConfigSchema().ImpliedType(),
+		Upgrade: schemautil.DiskSpaceStateUpgrade,
+		Version: version,
+	}
+}
+
 func Flink() []schema.StateUpgrader {
 	return []schema.StateUpgrader{
 		{
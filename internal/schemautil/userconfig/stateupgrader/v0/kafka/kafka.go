@@ -5,10 +5,10 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/aiven/aiven-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader/typeupgrader"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader/v0/dist"
@@ -122,7 +122,7 @@ func ResourceKafka() *schema.Resource {
 			customdiff.ComputedIf("karapace", func(ctx context.Context, d *schema.ResourceDiff, m interface{}) bool {
 				project := d.Get("project").(string)
 				serviceName := d.Get("service_name").(string)
-				client := m.(*aiven.Client)
+				client := m.(*common.ProviderMeta).Client
 
 				kafka, err := client.Services.Get(project, serviceName)
 				if err != nil {
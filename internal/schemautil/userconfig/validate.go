@@ -0,0 +1,117 @@
+package userconfig
+
+import (
+	"fmt"
+	"math"
+)
+
+// ValidateRaw checks a raw user configuration, e.g. decoded from JSON, against the schema of the n type: its
+// properties must be known and their values of the expected types. Types that have no schema are not checked, so
+// that types launched after the schemas were generated can be used.
+func ValidateRaw(st SchemaType, n string, v map[string]interface{}) error {
+	rm, err := CachedRepresentationMap(st)
+	if err != nil {
+		return err
+	}
+
+	s, ok := rm[n].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return validateRawValue(n, s, v)
+}
+
+// validateRawValue checks the value at the path against the schema s.
+func validateRawValue(path string, s map[string]interface{}, v interface{}) error {
+	types, err := schemaTypes(s)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	t := rawValueType(v)
+
+	if len(types) > 0 && !typeAllowed(types, t) {
+		return fmt.Errorf("%s: expected %v, got %s", path, types, t)
+	}
+
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		props, ok := s["properties"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		for k, pv := range vv {
+			ps, ok := props[k].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s: unknown property %q", path, k)
+			}
+
+			if err := validateRawValue(path+"."+k, ps, pv); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		items, ok := s["items"].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		for i, iv := range vv {
+			if err := validateRawValue(fmt.Sprintf("%s[%d]", path, i), items, iv); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// schemaTypes returns the types the schema allows, the type of a schema is either a string or a list of strings.
+func schemaTypes(s map[string]interface{}) ([]string, error) {
+	switch t := s["type"].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{t}, nil
+	case []interface{}:
+		return mustStringSlice(t)
+	default:
+		return nil, fmt.Errorf("unexpected schema type %v", t)
+	}
+}
+
+// rawValueType returns the schema type of a value decoded from JSON.
+func rawValueType(v interface{}) string {
+	switch vv := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if vv == math.Trunc(vv) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// typeAllowed returns true if the value type t is one of the types, an integer is also a number.
+func typeAllowed(types []string, t string) bool {
+	for _, allowed := range types {
+		if allowed == t || (allowed == "number" && t == "integer") {
+			return true
+		}
+	}
+
+	return false
+}
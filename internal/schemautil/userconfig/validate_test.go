@@ -0,0 +1,61 @@
+package userconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRaw(t *testing.T) {
+	tests := []struct {
+		name    string
+		st      string
+		v       map[string]interface{}
+		wantErr string
+	}{
+		{
+			"valid",
+			"pg",
+			map[string]interface{}{
+				"pg_version": "15",
+				"ip_filter":  []interface{}{"10.0.0.0/8", map[string]interface{}{"network": "0.0.0.0/0"}},
+				"pg":         map[string]interface{}{"autovacuum_analyze_threshold": float64(50)},
+			},
+			"",
+		},
+		{
+			"unknown_property",
+			"pg",
+			map[string]interface{}{"pg": map[string]interface{}{"autovacuum_analyze_treshold": float64(50)}},
+			`pg.pg: unknown property "autovacuum_analyze_treshold"`,
+		},
+		{
+			"wrong_type",
+			"pg",
+			map[string]interface{}{"backup_hour": "3"},
+			"pg.backup_hour: expected [integer null], got string",
+		},
+		{
+			"wrong_item_type",
+			"pg",
+			map[string]interface{}{"ip_filter": []interface{}{true}},
+			"pg.ip_filter[0]: expected [string object], got boolean",
+		},
+		{
+			"unknown_service_type",
+			"brand_new_service",
+			map[string]interface{}{"anything": true},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRaw(ServiceTypes, tt.st, tt.v)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,14 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net"
-	"strconv"
 	"time"
 
 	"github.com/aiven/aiven-go-client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
 const (
@@ -19,17 +19,62 @@ const (
 	aivenPendingState          = "REBUILDING"
 	aivenRebalancingState      = "REBALANCING"
 	aivenServicesStartingState = "WAITING_FOR_SERVICES"
+	aivenPoweroffState         = "POWEROFF"
 )
 
-// nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
+const (
+	// WaitForStateRunning waits until the service is running and ready to use.
+	WaitForStateRunning = "running"
+	// WaitForStateRebuildingOK waits until the service is being built or running, without the readiness checks.
+	WaitForStateRebuildingOK = "rebuilding_ok"
+	// WaitForStateNone does not wait for the service.
+	WaitForStateNone = "none"
+)
+
+// WaitForServiceCreation waits for the service of d to reach the state set by its wait_for_state attribute.
 func WaitForServiceCreation(ctx context.Context, d *schema.ResourceData, m interface{}) (*aiven.Service, error) {
-	client := m.(*aiven.Client)
+	waitFor := d.Get("wait_for_state").(string)
 
-	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+	// a service can only be powered off once it is running
+	if !d.Get("powered").(bool) {
+		waitFor = WaitForStateRunning
+	}
+
+	return WaitForServiceState(
+		ctx,
+		m,
+		d.Get("project").(string),
+		d.Get("service_name").(string),
+		staticIpsForServiceFromSchema(d),
+		d.Timeout(schema.TimeoutCreate),
+		waitFor,
+		ReadinessChecksFromSchema(d),
+	)
+}
+
+// WaitForServiceState waits for the service to reach the waitFor state, one of WaitForStateRunning,
+// WaitForStateRebuildingOK or WaitForStateNone. When waiting for a running service, it must also pass the readiness
+// checks that apply to it, apart from the ones turned off in checks.
+// nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
+func WaitForServiceState(
+	ctx context.Context,
+	m interface{},
+	projectName, serviceName string,
+	staticIps []string,
+	timeout time.Duration,
+	waitFor string,
+	checks map[string]bool,
+) (*aiven.Service, error) {
+	client := m.(*common.ProviderMeta).Client
+
+	if waitFor == WaitForStateNone {
+		return client.Services.Get(projectName, serviceName)
+	}
 
-	timeout := d.Timeout(schema.TimeoutCreate)
 	log.Printf("[DEBUG] Service creation waiter timeout %.0f minutes", timeout.Minutes())
 
+	progress := &serviceProgressLogger{}
+
 	conf := &resource.StateChangeConf{
 		Pending:                   []string{aivenPendingState, aivenRebalancingState, aivenServicesStartingState},
 		Target:                    []string{aivenTargetState},
@@ -43,6 +88,8 @@ func WaitForServiceCreation(ctx context.Context, d *schema.ResourceData, m inter
 				return nil, "", fmt.Errorf("unable to fetch service from api: %w", err)
 			}
 
+			progress.log(service)
+
 			state := service.State
 
 			if state != aivenTargetState {
@@ -50,43 +97,63 @@ func WaitForServiceCreation(ctx context.Context, d *schema.ResourceData, m inter
 				return service, state, nil
 			}
 
-			if rdy := backupsReady(service); !rdy {
-				log.Printf("[DEBUG] service reports as %s, still waiting for service backups", state)
+			if check, err := ServiceNotReady(NewReadinessTarget(m, projectName, service, staticIps), checks); err != nil {
+				return nil, "", err
+			} else if check != "" {
+				log.Printf("[DEBUG] service reports as %s, still waiting for readiness check %s", state, check)
 				return service, aivenServicesStartingState, nil
 			}
 
-			if rdy := grafanaReady(service); !rdy {
-				log.Printf("[DEBUG] service reports as %s, still waiting for grafana", state)
-				return service, aivenServicesStartingState, nil
-			}
+			return service, state, nil
+		},
+	}
 
-			if rdy, err := staticIpsReady(d, m); err != nil {
-				return nil, "", fmt.Errorf("unable to check if static ips are ready: %w", err)
-			} else if !rdy {
-				log.Printf("[DEBUG] service reports as %s, still waiting for static ips", state)
-				return service, aivenServicesStartingState, nil
+	// a service being built is good enough, there is no need to check its readiness
+	if waitFor == WaitForStateRebuildingOK {
+		conf.Pending = []string{}
+		conf.Target = []string{aivenPendingState, aivenRebalancingState, aivenTargetState}
+		conf.Delay = 0
+		conf.ContinuousTargetOccurence = 1
+		conf.Refresh = func() (interface{}, string, error) {
+			service, err := client.Services.Get(projectName, serviceName)
+			if err != nil {
+				return nil, "", fmt.Errorf("unable to fetch service from api: %w", err)
 			}
 
-			return service, state, nil
-		},
+			progress.log(service)
+
+			return service, service.State, nil
+		}
 	}
 
 	aux, err := conf.WaitForStateContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to wait for service state change: %w", err)
+		return nil, withServiceDiagnostics(
+			fmt.Errorf("unable to wait for service state change: %w", err), m, projectName, serviceName,
+		)
 	}
 	return aux.(*aiven.Service), nil
 }
 
 // nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
 func WaitForServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (*aiven.Service, error) {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
+	powered := d.Get("powered").(bool)
+	waitFor := d.Get("wait_for_state").(string)
+	staticIps := staticIpsForServiceFromSchema(d)
+	checks := ReadinessChecksFromSchema(d)
+
+	if waitFor == WaitForStateNone {
+		return client.Services.Get(projectName, serviceName)
+	}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
 	log.Printf("[DEBUG] Service update waiter timeout %.0f minutes", timeout.Minutes())
 
+	progress := &serviceProgressLogger{}
+
 	conf := &resource.StateChangeConf{
 		Pending:                   []string{"updating"},
 		Target:                    []string{"updated"},
@@ -100,22 +167,32 @@ func WaitForServiceUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 				return nil, "", fmt.Errorf("unable to fetch service from api: %w", err)
 			}
 
+			progress.log(service)
+
 			state := service.State
 
-			if rdy := backupsReady(service); !rdy {
-				log.Printf("[DEBUG] service reports as %s, still waiting for service backups", state)
-				return service, "updating", nil
+			// a powered off service has no backups, components or static ips to wait for
+			if !powered {
+				if state != aivenPoweroffState {
+					log.Printf("[DEBUG] service reports as %s, still waiting for it to be powered off", state)
+					return service, "updating", nil
+				}
+				return service, "updated", nil
 			}
 
-			if rdy := grafanaReady(service); !rdy {
-				log.Printf("[DEBUG] service reports as %s, still waiting for grafana", state)
+			if state == aivenPoweroffState {
+				log.Printf("[DEBUG] service reports as %s, still waiting for it to be powered on", state)
 				return service, "updating", nil
 			}
 
-			if rdy, err := staticIpsReady(d, m); err != nil {
-				return nil, "", fmt.Errorf("unable to check if static ips are ready: %w", err)
-			} else if !rdy {
-				log.Printf("[DEBUG] service reports as %s, still waiting for static ips", state)
+			if waitFor == WaitForStateRebuildingOK {
+				return service, "updated", nil
+			}
+
+			if check, err := ServiceNotReady(NewReadinessTarget(m, projectName, service, staticIps), checks); err != nil {
+				return nil, "", err
+			} else if check != "" {
+				log.Printf("[DEBUG] service reports as %s, still waiting for readiness check %s", state, check)
 				return service, "updating", nil
 			}
 
@@ -125,7 +202,9 @@ func WaitForServiceUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	aux, err := conf.WaitForStateContext(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to wait for service state change: %w", err)
+		return nil, withServiceDiagnostics(
+			fmt.Errorf("unable to wait for service state change: %w", err), m, projectName, serviceName,
+		)
 	}
 	return aux.(*aiven.Service), nil
 }
@@ -162,7 +241,7 @@ func WaitStaticIpsDissassociation(ctx context.Context, d *schema.ResourceData, m
 
 // nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
 func WaitForDeletion(ctx context.Context, d *schema.ResourceData, m interface{}) error {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
 
@@ -200,108 +279,6 @@ func WaitForDeletion(ctx context.Context, d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func grafanaReady(service *aiven.Service) bool {
-	if service.Type != "grafana" {
-		return true
-	}
-
-	// if IP filter is anything but 0.0.0.0/0 skip Grafana service availability checks
-	ipFilters, ok := service.UserConfig["ip_filter"]
-	if ok {
-		f := ipFilters.([]interface{})
-		if len(f) > 1 {
-			log.Printf("[DEBUG] grafana serivce has `%+v` ip filters, and availability checks will be skipped", ipFilters)
-
-			return true
-		}
-
-		if len(f) == 1 {
-			if f[0] != "0.0.0.0/0" {
-				log.Printf("[DEBUG] grafana serivce has `%+v` ip filters, and availability checks will be skipped", ipFilters)
-
-				return true
-			}
-		}
-	}
-
-	var publicGrafana string
-
-	// constructing grafana public address if available
-	for _, component := range service.Components {
-		if component.Route == "public" && component.Usage == "primary" {
-			publicGrafana = component.Host + ":" + strconv.Itoa(component.Port)
-			continue
-		}
-	}
-
-	// checking if public grafana is reachable
-	if publicGrafana != "" {
-		_, err := net.DialTimeout("tcp", publicGrafana, 1*time.Second)
-		if err != nil {
-			log.Printf("[DEBUG] public grafana is not yet reachable")
-			return false
-		}
-
-		log.Printf("[DEBUG] public grafana is reachable")
-		return true
-	}
-
-	return true
-}
-
-func backupsReady(service *aiven.Service) bool {
-	if service.Type != "pg" && service.Type != "elasticsearch" &&
-		service.Type != "redis" && service.Type != "influxdb" {
-		return true
-	}
-
-	if service.Type == "redis" && service.UserConfig["redis_persistence"] == "off" {
-		return true
-	}
-
-	// no backups for read replicas type of service
-	for _, i := range service.Integrations {
-		if i.IntegrationType == "read_replica" && *i.DestinationService == service.Name {
-			return true
-		}
-	}
-
-	return len(service.Backups) > 0
-}
-
-// staticIpsReady checks that the static ips that are associated with the service are either
-// in state 'assigned' or 'available'
-func staticIpsReady(d *schema.ResourceData, m interface{}) (bool, error) {
-	expectedStaticIps := staticIpsForServiceFromSchema(d)
-	if len(expectedStaticIps) == 0 {
-		return true, nil
-	}
-
-	client := m.(*aiven.Client)
-	projectName, serviceName := d.Get("project").(string), d.Get("service_name").(string)
-
-	staticIpsList, err := client.StaticIPs.List(projectName)
-	if err != nil {
-		return false, fmt.Errorf("unable to fetch static ips for project '%s': '%w", projectName, err)
-	}
-
-L:
-	for _, eip := range expectedStaticIps {
-		for _, sip := range staticIpsList.StaticIPs {
-			assignedOrAvailable := sip.State == StaticIPAssigned || sip.State == StaticIPAvailable
-			belongsToService := sip.ServiceName == serviceName
-			isExpectedIP := sip.StaticIPAddressID == eip
-
-			if isExpectedIP && belongsToService && assignedOrAvailable {
-				continue L
-			}
-		}
-		return false, nil
-	}
-
-	return true, nil
-}
-
 // staticIpsDisassociatedAfterServiceDeletion checks that after service deletion
 // all static ips that were associated to the service are available again
 func staticIpsDisassociatedAfterServiceDeletion(d *schema.ResourceData, m interface{}) (bool, error) {
@@ -310,7 +287,7 @@ func staticIpsDisassociatedAfterServiceDeletion(d *schema.ResourceData, m interf
 		return true, nil
 	}
 
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	projectName := d.Get("project").(string)
 
 	staticIpsList, err := client.StaticIPs.List(projectName)
@@ -336,7 +313,7 @@ func staticIpsDisassociatedAfterServiceDeletion(d *schema.ResourceData, m interf
 // staticIpsAreDisassociated checks that after service update
 // all static ips that are not used by the service anymore are available again
 func staticIpsAreDisassociated(d *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
 
@@ -5,47 +5,191 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/account"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/cassandra"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/clickhouse"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/cloud"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/connectionpool"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/flink"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/genericservice"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/grafana"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/influxdb"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/kafka"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/m3db"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/mysql"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/opensearch"
-	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/organization"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/pg"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/project"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/redis"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/servicebackups"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/servicecomponent"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/serviceintegration"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/servicelist"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/serviceplan"
+	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/servicereadiness"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/staticip"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/vpc"
 )
 
-// Provider returns terraform.ResourceProvider.
+// Provider returns terraform.ResourceProvider. The tokens returned by api_token_command are kept in tokenCache,
+// which is shared with the framework provider of the same provider instance.
 //
 //goland:noinspection GoDeprecation
-func Provider(version string) *schema.Provider {
+func Provider(version string, tokenCache *common.TokenCache) *schema.Provider {
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("AIVEN_TOKEN", nil),
-				Description: "Aiven Authentication Token",
+				Description: "Aiven Authentication Token. Can also be set with the `AIVEN_TOKEN` environment variable.",
+			},
+			"api_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_TOKEN_FILE", nil),
+				Description: "Path to a file containing the Aiven Authentication Token. Used when `api_token` " +
+					"is not set. Can also be set with the `AIVEN_TOKEN_FILE` environment variable.",
+			},
+			"api_token_command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_TOKEN_COMMAND", nil),
+				Description: "Command run with the system shell that prints the Aiven Authentication Token to its " +
+					"standard output. The command runs once per provider configuration in a Terraform run. Used when " +
+					"`api_token` and `api_token_file` are not set. Can also be set with the `AIVEN_TOKEN_COMMAND` " +
+					"environment variable.",
+			},
+			"api_token_profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_PROFILE", nil),
+				Description: "Name of the profile in the credentials file to read the Aiven Authentication Token " +
+					"from. Used when `api_token`, `api_token_file` and `api_token_command` are not set. " +
+					"Can also be set with the `AIVEN_PROFILE` environment variable.",
+			},
+			"credentials_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to the credentials file to read the Aiven Authentication Token from, as written by the " +
+					"Aiven CLI. Defaults to `~/.config/aiven/aiven-credentials.json` when `api_token_profile` is set. " +
+					"Can also be set with the `AIVEN_CREDENTIALS_FILE` environment variable.",
+			},
+			"api_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_WEB_URL", nil),
+				Description: "Base URL of the Aiven API, e.g. `https://api.aiven.io`. " +
+					"Can also be set with the `AIVEN_WEB_URL` environment variable.",
+			},
+			"ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_CA_BUNDLE", nil),
+				Description: "PEM encoded CA certificates to trust in addition to the system ones when connecting " +
+					"to the Aiven API. Can also be set with the `AIVEN_CA_BUNDLE` environment variable.",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_CA_CERT", nil),
+				Description: "Path to a file with PEM encoded CA certificates to trust in addition to the system ones " +
+					"when connecting to the Aiven API. Can also be set with the `AIVEN_CA_CERT` environment variable.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_INSECURE_SKIP_VERIFY", false),
+				Description: "Disables TLS certificate verification of the Aiven API. Use for testing only. " +
+					"Can also be set with the `AIVEN_INSECURE_SKIP_VERIFY` environment variable.",
+			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_HTTP_PROXY", nil),
+				Description: "URL of the proxy used to connect to the Aiven API. When not set, the standard " +
+					"`HTTPS_PROXY` and `NO_PROXY` environment variables are used. " +
+					"Can also be set with the `AIVEN_HTTP_PROXY` environment variable.",
+			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AIVEN_MAX_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description: "Maximum number of requests per second sent to the Aiven API. `0` means no limit. " +
+					"Can also be set with the `AIVEN_MAX_REQUESTS_PER_SECOND` environment variable.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AIVEN_MAX_RETRIES", common.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "Maximum number of times a request is retried with an exponential backoff when the " +
+					"Aiven API responds with 429, 502, 503 or 504, or cannot be connected to. The `Retry-After` header " +
+					"is honoured. Can also be set with the `AIVEN_MAX_RETRIES` environment variable.",
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_PROJECT_NAME", nil),
+				Description: "Default project of the resources and data sources that do not set `project`. " +
+					"Can also be set with the `AIVEN_PROJECT_NAME` environment variable.",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AIVEN_READ_ONLY", false),
+				Description: "Fail the plan of any create, update or destroy of a resource. " +
+					"Can also be set with the `AIVEN_READ_ONLY` environment variable.",
+			},
+			"prevent_destroy_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Resource types, e.g. `aiven_kafka`, whose destroy or replacement fails the plan.",
+			},
+			"max_monthly_cost_usd": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AIVEN_MAX_MONTHLY_COST_USD", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description: "Fail the plan when the summed `estimated_monthly_cost_usd` of the services in the " +
+					"configuration is over this amount. `0` means no limit. " +
+					"Can also be set with the `AIVEN_MAX_MONTHLY_COST_USD` environment variable.",
+			},
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "Tags added to every service, project and Kafka topic managed by the provider. " +
+					"Tags set on a resource take precedence over the default tags with the same key.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags as key-value pairs.",
+						},
+					},
+				},
 			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"aiven_connection_pool":   connectionpool.DatasourceConnectionPool(),
+			"aiven_service":           genericservice.DatasourceService(),
+			"aiven_service_backups":   servicebackups.DatasourceServiceBackups(),
 			"aiven_service_component": servicecomponent.DatasourceServiceComponent(),
+			"aiven_service_readiness": servicereadiness.DatasourceServiceReadiness(),
+			"aiven_services":          servicelist.DatasourceServices(),
+			"aiven_service_plans":     serviceplan.DatasourceServicePlans(),
+			"aiven_clouds":            cloud.DatasourceClouds(),
 
 			// influxdb
 			"aiven_influxdb":          influxdb.DatasourceInfluxDB(),
@@ -80,10 +224,6 @@ func Provider(version string) *schema.Provider {
 			"aiven_account_team_member":    account.DatasourceAccountTeamMember(),
 			"aiven_account_authentication": account.DatasourceAccountAuthentication(),
 
-			// organization
-			"aiven_organization":        organization.DatasourceOrganization(),
-			"aiven_organizational_unit": organization.DatasourceOrganizationalUnit(),
-
 			// project
 			"aiven_project":       project.DatasourceProject(),
 			"aiven_project_user":  project.DatasourceProjectUser(),
@@ -140,6 +280,7 @@ func Provider(version string) *schema.Provider {
 		ResourcesMap: map[string]*schema.Resource{
 			"aiven_connection_pool": connectionpool.ResourceConnectionPool(),
 			"aiven_static_ip":       staticip.ResourceStaticIP(),
+			"aiven_service":         genericservice.ResourceService(),
 
 			// influxdb
 			"aiven_influxdb":          influxdb.ResourceInfluxDB(),
@@ -174,10 +315,6 @@ func Provider(version string) *schema.Provider {
 			"aiven_account_team_member":    account.ResourceAccountTeamMember(),
 			"aiven_account_authentication": account.ResourceAccountAuthentication(),
 
-			// organization
-			"aiven_organization":        organization.ResourceOrganization(),
-			"aiven_organizational_unit": organization.ResourceOrganizationalUnit(),
-
 			// project
 			"aiven_project":       project.ResourceProject(),
 			"aiven_project_user":  project.ResourceProjectUser(),
@@ -236,14 +373,64 @@ func Provider(version string) *schema.Provider {
 		},
 	}
 
-	p.ConfigureContextFunc = func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		client, err := common.NewCustomAivenClient(d.Get("api_token").(string), p.TerraformVersion, version)
+	for name, r := range p.ResourcesMap {
+		// The project attribute of aiven_project is the name of the project it creates.
+		if name != "aiven_project" {
+			schemautil.ResourceWithDefaultProject(r)
+		}
+
+		schemautil.ResourceWithPoweredOffService(r)
+	}
+
+	for _, r := range p.DataSourcesMap {
+		schemautil.DatasourceWithDefaultProject(r)
+	}
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		token, err := common.ResolveToken(ctx, common.TokenOptions{
+			Token:           d.Get("api_token").(string),
+			TokenFile:       d.Get("api_token_file").(string),
+			TokenCommand:    d.Get("api_token_command").(string),
+			Profile:         d.Get("api_token_profile").(string),
+			CredentialsFile: d.Get("credentials_file").(string),
+			Cache:           tokenCache,
+		})
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		return client, nil
+		opts := common.ClientOptions{
+			APIURL:               d.Get("api_url").(string),
+			CABundle:             d.Get("ca_bundle").(string),
+			CABundleFile:         d.Get("ca_bundle_file").(string),
+			InsecureSkipVerify:   d.Get("insecure_skip_verify").(bool),
+			HTTPProxy:            d.Get("http_proxy").(string),
+			MaxRequestsPerSecond: d.Get("max_requests_per_second").(float64),
+			MaxRetries:           d.Get("max_retries").(int),
+		}
+
+		client, err := common.NewSharedAivenClient(token, p.TerraformVersion, version, opts)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return common.NewProviderMeta(client, &common.ProviderConfig{
+			DefaultTags:       defaultTags(d),
+			Project:           d.Get("project").(string),
+			MaxMonthlyCostUSD: d.Get("max_monthly_cost_usd").(float64),
+		}), nil
 	}
 
 	return p
 }
+
+// defaultTags returns the tags of the default_tags block.
+func defaultTags(d *schema.ResourceData) map[string]string {
+	tags := make(map[string]string)
+
+	for k, v := range d.Get("default_tags.0.tags").(map[string]interface{}) {
+		tags[k] = v.(string)
+	}
+
+	return tags
+}
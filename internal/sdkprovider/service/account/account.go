@@ -3,6 +3,7 @@ package account
 import (
 	"context"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 
 	"github.com/aiven/aiven-go-client"
@@ -77,7 +78,7 @@ func ResourceAccount() *schema.Resource {
 }
 
 func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	name := d.Get("name").(string)
 	bgID := d.Get("primary_billing_group_id").(string)
 
@@ -97,7 +98,7 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceAccountRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	r, err := client.Accounts.Get(d.Id())
 	if err != nil {
@@ -133,7 +134,7 @@ func resourceAccountRead(_ context.Context, d *schema.ResourceData, m interface{
 }
 
 func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	r, err := client.Accounts.Update(d.Id(), aiven.Account{
 		Name:                  d.Get("name").(string),
@@ -149,7 +150,7 @@ func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceAccountDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	err := client.Accounts.Delete(d.Id())
 	if err != nil && !aiven.IsNotFound(err) {
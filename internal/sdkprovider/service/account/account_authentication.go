@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -158,7 +159,7 @@ func ResourceAccountAuthentication() *schema.Resource {
 }
 
 func resourceAccountAuthenticationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID := d.Get("account_id").(string)
 	r, err := client.AccountAuthentications.Create(
@@ -189,7 +190,7 @@ func resourceAccountAuthenticationCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAccountAuthenticationRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, authID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -275,7 +276,7 @@ func flattenSAMLFieldMapping(s *aiven.SAMLFieldMapping) []map[string]interface{}
 }
 
 func resourceAccountAuthenticationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	accountID, authID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -305,7 +306,7 @@ func resourceAccountAuthenticationUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAccountAuthenticationDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -3,11 +3,10 @@ package account
 import (
 	"context"
 
-	"github.com/aiven/aiven-go-client"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
@@ -21,7 +20,7 @@ func DatasourceAccountAuthentication() *schema.Resource {
 }
 
 func datasourceAccountAuthenticationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
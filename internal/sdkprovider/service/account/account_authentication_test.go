@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
@@ -210,7 +211,7 @@ data "aiven_account_authentication" "auth" {
 }
 
 func testAccCheckAivenAccountAuthenticationResourceDestroy(s *terraform.State) error {
-	c := acc.TestAccProvider.Meta().(*aiven.Client)
+	c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each account authentication is destroyed
 	for _, rs := range s.RootModule().Resources {
@@ -256,7 +257,7 @@ func testAccCheckAivenAccountAuthenticationResourceDestroy(s *terraform.State) e
 }
 
 func testAccCheckAivenAccountAuthenticationWithAutoJoinTeamIDResourceDestroy(s *terraform.State) error {
-	c := acc.TestAccProvider.Meta().(*aiven.Client)
+	c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each account authentication is destroyed
 	for _, rs := range s.RootModule().Resources {
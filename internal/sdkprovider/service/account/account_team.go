@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -55,7 +56,7 @@ func ResourceAccountTeam() *schema.Resource {
 }
 
 func resourceAccountTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	name := d.Get("name").(string)
 	accountID := d.Get("account_id").(string)
 
@@ -75,7 +76,7 @@ func resourceAccountTeamCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceAccountTeamRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -107,7 +108,7 @@ func resourceAccountTeamRead(_ context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceAccountTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	accountID, teamID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -126,7 +127,7 @@ func resourceAccountTeamUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceAccountTeamDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -72,7 +73,7 @@ eliminate an account team member if one has accepted an invitation previously.
 }
 
 func resourceAccountTeamMemberCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	accountID := d.Get("account_id").(string)
 	teamID := d.Get("team_id").(string)
 	userEmail := d.Get("user_email").(string)
@@ -92,7 +93,7 @@ func resourceAccountTeamMemberCreate(ctx context.Context, d *schema.ResourceData
 
 func resourceAccountTeamMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var found bool
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, userEmail, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -175,7 +176,7 @@ func resourceAccountTeamMemberRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAccountTeamMemberDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, userEmail, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
@@ -62,7 +63,7 @@ data "aiven_account_team_member" "member" {
 }
 
 func testAccCheckAivenAccountTeamMemberResourceDestroy(s *terraform.State) error {
-	c := acc.TestAccProvider.Meta().(*aiven.Client)
+	c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each account team project is destroyed
 	for _, rs := range s.RootModule().Resources {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -60,7 +61,7 @@ account team you are trying to link to this project.
 }
 
 func resourceAccountTeamProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID := d.Get("account_id").(string)
 	teamID := d.Get("team_id").(string)
@@ -85,7 +86,7 @@ func resourceAccountTeamProjectCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAccountTeamProjectRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, projectName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -125,7 +126,7 @@ func resourceAccountTeamProjectRead(_ context.Context, d *schema.ResourceData, m
 }
 
 func resourceAccountTeamProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, _, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -149,7 +150,7 @@ func resourceAccountTeamProjectUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAccountTeamProjectDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	accountID, teamID, projectName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
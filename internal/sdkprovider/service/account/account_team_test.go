@@ -7,6 +7,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -53,7 +54,7 @@ data "aiven_account_team" "team" {
 }
 
 func testAccCheckAivenAccountTeamResourceDestroy(s *terraform.State) error {
-	c := acc.TestAccProvider.Meta().(*aiven.Client)
+	c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each account team is destroyed
 	for _, rs := range s.RootModule().Resources {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -38,7 +39,7 @@ func TestAccAivenCassandraUser_basic(t *testing.T) {
 }
 
 func testAccCheckAivenCassandraUserResourceDestroy(s *terraform.State) error {
-	c := acc.TestAccProvider.Meta().(*aiven.Client)
+	c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each aiven_cassandra_user is destroyed
 	for _, rs := range s.RootModule().Resources {
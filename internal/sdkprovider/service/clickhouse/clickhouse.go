@@ -3,7 +3,7 @@ package clickhouse
 import (
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/dist"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,14 +14,24 @@ func clickhouseSchema() map[string]*schema.Schema {
 		Computed:    true,
 		Description: "Clickhouse server provided values",
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{},
+			Schema: map[string]*schema.Schema{
+				"uris": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "ClickHouse server URIs",
+					Sensitive:   true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
 		},
 	}
 	s[schemautil.ServiceTypeClickhouse+"_user_config"] = dist.ServiceTypeClickhouse()
 	s["service_integrations"] = &schema.Schema{
 		Type:        schema.TypeList,
 		Optional:    true,
-		Description: "Service integrations to specify when creating a service. Not applied after initial service creation",
+		Description: "Service integrations this service is the destination of. Integrations added to or removed from it after the service creation are created or deleted. Only these integrations are read back: integrations managed otherwise, e.g. with the `aiven_service_integration` resource or in the console, are left out and never deleted.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"source_service_name": {
@@ -32,7 +42,7 @@ func clickhouseSchema() map[string]*schema.Schema {
 				"integration_type": {
 					Type:        schema.TypeString,
 					Required:    true,
-					Description: "Type of the service integration. The only supported values at the moment are `clickhouse_kafka` and `clickhouse_postgresql`.",
+					Description: "Type of the service integration. The supported values are `clickhouse_kafka` and `clickhouse_postgresql`.",
 				},
 			},
 		},
@@ -48,35 +58,14 @@ func ResourceClickhouse() *schema.Resource {
 		ReadContext:   schemautil.ResourceServiceRead,
 		UpdateContext: schemautil.ResourceServiceUpdate,
 		DeleteContext: schemautil.ResourceServiceDelete,
-		CustomizeDiff: customdiff.Sequence(
-			schemautil.SetServiceTypeIfEmpty(schemautil.ServiceTypeClickhouse),
-			schemautil.CustomizeDiffDisallowMultipleManyToOneKeys,
-			customdiff.IfValueChange("tag",
-				schemautil.TagsShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckUniqueTag,
-			),
-			customdiff.IfValueChange("disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("additional_disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("service_integrations",
-				schemautil.ServiceIntegrationShouldNotBeEmpty,
-				schemautil.CustomizeDiffServiceIntegrationAfterCreation,
-			),
-			customdiff.Sequence(
-				schemautil.CustomizeDiffCheckPlanAndStaticIpsCannotBeModifiedTogether,
-				schemautil.CustomizeDiffCheckStaticIPDisassociation,
-			),
-		),
+		CustomizeDiff: schemautil.CustomizeDiffGenericService(schemautil.ServiceTypeClickhouse),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: schemautil.DefaultResourceTimeouts(),
 
-		Schema: clickhouseSchema(),
+		Schema:         clickhouseSchema(),
+		SchemaVersion:  1,
+		StateUpgraders: []schema.StateUpgrader{stateupgrader.DiskSpace(0, clickhouseSchema())},
 	}
 }
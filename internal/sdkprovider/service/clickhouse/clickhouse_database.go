@@ -3,7 +3,7 @@ package clickhouse
 import (
 	"context"
 
-	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -45,7 +45,7 @@ func ResourceClickhouseDatabase() *schema.Resource {
 }
 
 func resourceClickhouseDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -62,7 +62,7 @@ func resourceClickhouseDatabaseCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceClickhouseDatabaseRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -77,12 +77,18 @@ func resourceClickhouseDatabaseRead(_ context.Context, d *schema.ResourceData, m
 	if err := d.Set("name", database.Name); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("project", projectName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("service_name", serviceName); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
 
 func resourceClickhouseDatabaseDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -4,8 +4,7 @@ import (
 	"context"
 	"regexp"
 
-	"github.com/aiven/aiven-go-client"
-
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -112,7 +111,7 @@ Notes:
 }
 
 func resourceClickhouseGrantCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	serviceName := d.Get("service_name").(string)
 	projectName := d.Get("project").(string)
@@ -159,7 +158,7 @@ func setUserOrRole(d *schema.ResourceData, granteeType, userOrRole string) error
 }
 
 func resourceClickhouseGrantRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, granteeType, userOrRole, err := schemautil.SplitResourceID4(d.Id())
 	if err != nil {
@@ -197,7 +196,7 @@ func resourceClickhouseGrantRead(_ context.Context, d *schema.ResourceData, m in
 }
 
 func resourceClickhouseGrantDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -3,7 +3,7 @@ package clickhouse
 import (
 	"context"
 
-	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -40,7 +40,7 @@ func ResourceClickhouseRole() *schema.Resource {
 }
 
 func resourceClickhouseRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -56,7 +56,7 @@ func resourceClickhouseRoleCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceClickhouseRoleRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, roleName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -82,7 +82,7 @@ func resourceClickhouseRoleRead(_ context.Context, d *schema.ResourceData, m int
 }
 
 func resourceClickhouseRoleDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, roleName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
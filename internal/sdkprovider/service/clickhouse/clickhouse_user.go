@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -57,7 +58,7 @@ func ResourceClickhouseUser() *schema.Resource {
 }
 
 func resourceClickhouseUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -81,7 +82,7 @@ func resourceClickhouseUserCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceClickhouseUserRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, uuid, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -113,7 +114,7 @@ func resourceClickhouseUserRead(_ context.Context, d *schema.ResourceData, m int
 }
 
 func resourceClickhouseUserDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, uuid, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
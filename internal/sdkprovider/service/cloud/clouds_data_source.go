@@ -0,0 +1,147 @@
+package cloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func DatasourceClouds() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Clouds data source provides the clouds services can run in, and the cloud nearest to a " +
+			"location when `latitude` and `longitude` are set.",
+		ReadContext: datasourceCloudsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Project name, to get the clouds available in the project instead of the public clouds.",
+			},
+			"provider_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the clouds of this provider, e.g. `aws`, `google` or `azure`.",
+			},
+			"geo_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the clouds in this region, e.g. `europe` or `north america`.",
+			},
+			"latitude": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"longitude"},
+				Description:  "Latitude of the location to find the nearest cloud of.",
+				ValidateFunc: validation.FloatBetween(-90, 90),
+			},
+			"longitude": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"latitude"},
+				Description:  "Longitude of the location to find the nearest cloud of.",
+				ValidateFunc: validation.FloatBetween(-180, 180),
+			},
+			"nearest_cloud_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the cloud nearest to `latitude` and `longitude`, among the filtered clouds.",
+			},
+			"clouds": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The clouds matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud name",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud description",
+						},
+						"geo_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Geographical region of the cloud",
+						},
+						"geo_latitude": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Latitude of the cloud",
+						},
+						"geo_longitude": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Longitude of the cloud",
+						},
+						"provider_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud provider",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceCloudsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectName := d.Get("project").(string)
+	provider := d.Get("provider_name").(string)
+	geoRegion := d.Get("geo_region").(string)
+
+	clouds, err := schemautil.ListClouds(ctx, m, projectName)
+	if err != nil {
+		return diag.Errorf("error getting the clouds: %s", err)
+	}
+
+	var filtered []*schemautil.Cloud
+	result := make([]map[string]interface{}, 0, len(clouds))
+
+	for _, c := range clouds {
+		if (provider != "" && c.Provider != provider) || (geoRegion != "" && !strings.EqualFold(c.GeoRegion, geoRegion)) {
+			continue
+		}
+
+		filtered = append(filtered, c)
+		result = append(result, map[string]interface{}{
+			"cloud_name":    c.CloudName,
+			"description":   c.CloudDescription,
+			"geo_region":    c.GeoRegion,
+			"geo_latitude":  c.GeoLatitude,
+			"geo_longitude": c.GeoLongitude,
+			"provider_name": c.Provider,
+		})
+	}
+
+	var nearest string
+	// the location may be on the equator or the prime meridian, the zero values are set values
+	if !d.GetRawConfig().GetAttr("latitude").IsNull() {
+		if c := schemautil.NearestCloud(filtered, d.Get("latitude").(float64), d.Get("longitude").(float64)); c != nil {
+			nearest = c.CloudName
+		}
+	}
+
+	d.SetId(projectName)
+	if projectName == "" {
+		d.SetId("clouds")
+	}
+
+	if err := d.Set("clouds", result); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("nearest_cloud_name", nearest); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
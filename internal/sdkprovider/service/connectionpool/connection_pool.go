@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 )
@@ -71,7 +72,7 @@ func ResourceConnectionPool() *schema.Resource {
 }
 
 func resourceConnectionPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -97,7 +98,7 @@ func resourceConnectionPoolCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceConnectionPoolRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, poolName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -118,7 +119,7 @@ func resourceConnectionPoolRead(_ context.Context, d *schema.ResourceData, m int
 }
 
 func resourceConnectionPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, poolName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -144,7 +145,7 @@ func resourceConnectionPoolUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceConnectionPoolDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, poolName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -71,7 +72,7 @@ func ResourceFlinkApplication() *schema.Resource {
 
 // resourceFlinkApplicationRead is the read function for the Flink Application resource.
 func resourceFlinkApplicationRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, ID, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -120,7 +121,7 @@ func resourceFlinkApplicationRead(_ context.Context, d *schema.ResourceData, m i
 
 // resourceFlinkApplicationCreate is the create function for the Flink Application resource.
 func resourceFlinkApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -138,7 +139,7 @@ func resourceFlinkApplicationCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceFlinkApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, ID, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -157,7 +158,7 @@ func resourceFlinkApplicationUpdate(ctx context.Context, d *schema.ResourceData,
 
 // resourceFlinkApplicationDelete is the delete function for the Flink Application resource.
 func resourceFlinkApplicationDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, ID, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
@@ -88,7 +89,7 @@ func resourceFlinkApplicationDeploymentCreate(
 	d *schema.ResourceData,
 	m interface{},
 ) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -130,7 +131,7 @@ func resourceFlinkApplicationDeploymentDelete(
 	d *schema.ResourceData,
 	m interface{},
 ) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, applicationID, deploymentID, err := schemautil.SplitResourceID4(d.Id())
 	if err != nil {
@@ -177,7 +178,7 @@ func resourceFlinkApplicationDeploymentDelete(
 
 // resourceFlinkApplicationDeploymentRead reads an existing Flink Application Deployment resource.
 func resourceFlinkApplicationDeploymentRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, applicationID, deploymentID, err := schemautil.SplitResourceID4(d.Id())
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -164,7 +165,7 @@ func ResourceFlinkApplicationVersion() *schema.Resource {
 
 // resourceFlinkApplicationVersionCreate is the create function for the Flink Application Version resource.
 func resourceFlinkApplicationVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -215,7 +216,7 @@ func expandFlinkApplicationVersionSourcesOrSinks(sources []interface{}) []aiven.
 
 // resourceFlinkApplicationVersionDelete is the delete function for the Flink Application Version resource.
 func resourceFlinkApplicationVersionDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, applicationID, version, err := schemautil.SplitResourceID4(d.Id())
 	if err != nil {
@@ -232,7 +233,7 @@ func resourceFlinkApplicationVersionDelete(_ context.Context, d *schema.Resource
 
 // resourceFlinkApplicationVersionRead is the read function for the Flink Application Version resource.
 func resourceFlinkApplicationVersionRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, applicationID, version, err := schemautil.SplitResourceID4(d.Id())
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/aiven/aiven-go-client"
 
 	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -51,7 +52,7 @@ func TestAccAivenFlinkApplicationVersion_basic(t *testing.T) {
 }
 
 func testAccCheckAivenFlinkDestroy(s *terraform.State) error {
-	client := acc.TestAccProvider.Meta().(*aiven.Client)
+	client := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aiven_flink_application_version" {
@@ -0,0 +1,54 @@
+package genericservice
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader"
+)
+
+func aivenServiceSchema() map[string]*schema.Schema {
+	s := schemautil.ServiceCommonSchema()
+	s["service_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		Description: userconfig.Desc("Aiven service type code, e.g. `pg` or `kafka`. Service types that the " +
+			"provider has no dedicated resource for are supported as well.").ForceNew().Build(),
+	}
+	s["user_config"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "Service type specific user configuration as a JSON object, e.g. " +
+			"`jsonencode({ pg_version = \"15\" })`. It is checked against the user configuration schema of the " +
+			"service type, when the provider knows it. Only the options that are set are read back, except on import " +
+			"and in the data source, which read all of them.",
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+	}
+
+	return s
+}
+
+func ResourceService() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Service resource allows the creation and management of Aiven services of any type, " +
+			"with the user configuration given as JSON.",
+		CreateContext: schemautil.ResourceServiceCreateWrapper("service"),
+		ReadContext:   schemautil.ResourceServiceRead,
+		UpdateContext: schemautil.ResourceServiceUpdate,
+		DeleteContext: schemautil.ResourceServiceDelete,
+		CustomizeDiff: schemautil.CustomizeDiffGenericService("service"),
+		Importer: &schema.ResourceImporter{
+			StateContext: schemautil.ImportGenericServiceState,
+		},
+		Timeouts: schemautil.DefaultResourceTimeouts(),
+
+		Schema:         aivenServiceSchema(),
+		SchemaVersion:  1,
+		StateUpgraders: []schema.StateUpgrader{stateupgrader.DiskSpace(0, aivenServiceSchema())},
+	}
+}
@@ -0,0 +1,15 @@
+package genericservice
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func DatasourceService() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: schemautil.DatasourceServiceRead,
+		Description: "The Service data source provides information about an existing Aiven service of any type.",
+		Schema:      schemautil.ResourceSchemaAsDatasourceSchema(aivenServiceSchema(), "project", "service_name"),
+	}
+}
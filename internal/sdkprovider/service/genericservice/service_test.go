@@ -0,0 +1,69 @@
+package genericservice_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAiven_service(t *testing.T) {
+	resourceName := "aiven_service.bar"
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acc.TestAccPreCheck(t) },
+		ProviderFactories: acc.TestAccProviderFactories,
+		CheckDestroy:      acc.TestAccCheckAivenServiceResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccServiceResource(rName, `{ redis_maxmemory_policy = 42 }`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ExpectError:        regexp.MustCompile("invalid user_config"),
+			},
+			{
+				Config: testAccServiceResource(rName, `{ redis_maxmemory_policy = "allkeys-random" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_name", fmt.Sprintf("test-acc-sr-%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "project", os.Getenv("AIVEN_PROJECT_NAME")),
+					resource.TestCheckResourceAttr(resourceName, "service_type", "redis"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+					resource.TestCheckResourceAttr(resourceName, "user_config", `{"redis_maxmemory_policy":"allkeys-random"}`),
+					resource.TestCheckResourceAttrSet(resourceName, "service_uri"),
+					resource.TestCheckResourceAttr("data.aiven_service.common", "service_type", "redis"),
+					resource.TestCheckResourceAttrSet("data.aiven_service.common", "user_config"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceResource(name, userConfig string) string {
+	return fmt.Sprintf(`
+data "aiven_project" "foo" {
+  project = "%s"
+}
+
+resource "aiven_service" "bar" {
+  project                 = data.aiven_project.foo.project
+  cloud_name              = "google-europe-west1"
+  plan                    = "startup-4"
+  service_name            = "test-acc-sr-%s"
+  service_type            = "redis"
+  maintenance_window_dow  = "monday"
+  maintenance_window_time = "10:00:00"
+  user_config             = jsonencode(%s)
+}
+
+data "aiven_service" "common" {
+  service_name = aiven_service.bar.service_name
+  project      = aiven_service.bar.project
+
+  depends_on = [aiven_service.bar]
+}`, os.Getenv("AIVEN_PROJECT_NAME"), name, userConfig)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -44,7 +45,7 @@ func ResourceInfluxDBDatabase() *schema.Resource {
 }
 
 func resourceInfluxDBDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -72,7 +73,7 @@ func resourceInfluxDBDatabaseUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceInfluxDBDatabaseRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -98,7 +99,7 @@ func resourceInfluxDBDatabaseRead(_ context.Context, d *schema.ResourceData, m i
 }
 
 func resourceInfluxDBDatabaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	acctest3 "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -38,7 +39,7 @@ func TestAccAivenInfluxDBUser_basic(t *testing.T) {
 }
 
 func testAccCheckAivenInfluxDBUserResourceDestroy(s *terraform.State) error {
-	c := acctest3.TestAccProvider.Meta().(*aiven.Client)
+	c := acctest3.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each aiven_influxdb_user is destroyed
 	for _, rs := range s.RootModule().Resources {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/dist"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader"
@@ -90,34 +91,13 @@ func ResourceKafka() *schema.Resource {
 
 		Schema: aivenKafkaSchema(),
 		CustomizeDiff: customdiff.Sequence(
-			schemautil.SetServiceTypeIfEmpty(schemautil.ServiceTypeKafka),
-			schemautil.CustomizeDiffDisallowMultipleManyToOneKeys,
-			customdiff.IfValueChange("tag",
-				schemautil.TagsShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckUniqueTag,
-			),
-			customdiff.IfValueChange("disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("additional_disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("service_integrations",
-				schemautil.ServiceIntegrationShouldNotBeEmpty,
-				schemautil.CustomizeDiffServiceIntegrationAfterCreation,
-			),
-			customdiff.Sequence(
-				schemautil.CustomizeDiffCheckPlanAndStaticIpsCannotBeModifiedTogether,
-				schemautil.CustomizeDiffCheckStaticIPDisassociation,
-			),
+			schemautil.CustomizeDiffGenericService(schemautil.ServiceTypeKafka),
 
 			// if a kafka_version is >= 3.0 then this schema field is not applicable
 			customdiff.ComputedIf("karapace", func(ctx context.Context, d *schema.ResourceDiff, m interface{}) bool {
 				project := d.Get("project").(string)
 				serviceName := d.Get("service_name").(string)
-				client := m.(*aiven.Client)
+				client := m.(*common.ProviderMeta).Client
 
 				kafka, err := client.Services.Get(project, serviceName)
 				if err != nil {
@@ -135,8 +115,8 @@ func ResourceKafka() *schema.Resource {
 				return false
 			}),
 		),
-		SchemaVersion:  1,
-		StateUpgraders: stateupgrader.Kafka(),
+		SchemaVersion:  2,
+		StateUpgraders: append(stateupgrader.Kafka(), stateupgrader.DiskSpace(1, aivenKafkaSchema())),
 	}
 }
 
@@ -147,7 +127,7 @@ func resourceKafkaCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	// if default_acl=false delete default wildcard Kafka ACL and ACLs for Schema Registry that are automatically created
 	if !d.Get("default_acl").(bool) {
-		client := m.(*aiven.Client)
+		client := m.(*common.ProviderMeta).Client
 		project := d.Get("project").(string)
 		serviceName := d.Get("service_name").(string)
 
@@ -176,7 +156,7 @@ func resourceKafkaCreate(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceKafkaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, service, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
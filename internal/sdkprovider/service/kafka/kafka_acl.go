@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
 	"github.com/aiven/aiven-go-client"
@@ -62,7 +63,7 @@ func ResourceKafkaACL() *schema.Resource {
 }
 
 func resourceKafkaACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -86,14 +87,14 @@ func resourceKafkaACLCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceKafkaACLRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, aclID, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	acl, err := kafkaACLCache{}.Read(project, serviceName, aclID, client)
+	acl, err := getACLCache(m).Read(project, serviceName, aclID, client)
 	if err != nil {
 		return diag.FromErr(schemautil.ResourceReadHandleNotFound(err, d))
 	}
@@ -107,7 +108,7 @@ func resourceKafkaACLRead(_ context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceKafkaACLDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, aclID, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -6,28 +6,37 @@ import (
 	"sync"
 
 	"github.com/aiven/aiven-go-client"
-)
 
-var (
-	acls         = make(map[string]map[string]aiven.KafkaACL)
-	aclCacheLock sync.Mutex
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
-// kafkaACLCache type
+// aclCacheKey is the key of the ACL cache in the provider meta
+type aclCacheKey struct{}
+
+// kafkaACLCache caches the Kafka ACLs of the services
 type kafkaACLCache struct {
+	sync.Mutex
+	acls map[serviceKey]map[string]aiven.KafkaACL
+}
+
+// getACLCache gets the ACL cache of the provider instance
+func getACLCache(m interface{}) *kafkaACLCache {
+	return m.(*common.ProviderMeta).Cache(aclCacheKey{}, func() interface{} {
+		return &kafkaACLCache{acls: make(map[serviceKey]map[string]aiven.KafkaACL)}
+	}).(*kafkaACLCache)
 }
 
 // Read populates the cache if it doesn't exist, and reads the required acl. An aiven.Error with status
 // 404 is returned upon cache miss
-func (a kafkaACLCache) Read(project, service, aclID string, client *aiven.Client) (acl aiven.KafkaACL, err error) {
-	aclCacheLock.Lock()
-	defer aclCacheLock.Unlock()
-	if _, ok := acls[project+service]; !ok {
+func (a *kafkaACLCache) Read(project, service, aclID string, client *aiven.Client) (acl aiven.KafkaACL, err error) {
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.acls[serviceKey{project, service}]; !ok {
 		if err = a.populateACLCache(project, service, client); err != nil {
 			return
 		}
 	}
-	if cachedService, ok := acls[project+service]; ok {
+	if cachedService, ok := a.acls[serviceKey{project, service}]; ok {
 		if acl, ok = cachedService[aclID]; !ok {
 			// cache miss, try to get the ACL from the Aiven API instead
 			log.Printf("Cache miss on ACL: %s, going live to Aiven API", aclID)
@@ -49,27 +58,27 @@ func (a kafkaACLCache) Read(project, service, aclID string, client *aiven.Client
 }
 
 // write writes the specified ACL to the cache
-func (a kafkaACLCache) write(project, service string, acl *aiven.KafkaACL) (err error) {
+func (a *kafkaACLCache) write(project, service string, acl *aiven.KafkaACL) (err error) {
 	var cachedService map[string]aiven.KafkaACL
 	var ok bool
-	if cachedService, ok = acls[project+service]; !ok {
+	if cachedService, ok = a.acls[serviceKey{project, service}]; !ok {
 		cachedService = make(map[string]aiven.KafkaACL)
 	}
 
 	cachedService[acl.ID] = *acl
-	acls[project+service] = cachedService
+	a.acls[serviceKey{project, service}] = cachedService
 	return
 }
 
 // Refresh refreshes the ACL cache
-func (a kafkaACLCache) Refresh(project, service string, client *aiven.Client) error {
-	aclCacheLock.Lock()
-	defer aclCacheLock.Unlock()
+func (a *kafkaACLCache) Refresh(project, service string, client *aiven.Client) error {
+	a.Lock()
+	defer a.Unlock()
 	return a.populateACLCache(project, service, client)
 }
 
 // populateACLCache makes a call to Aiven to list kafka ACLs, and upserts into the cache
-func (a kafkaACLCache) populateACLCache(project, service string, client *aiven.Client) (err error) {
+func (a *kafkaACLCache) populateACLCache(project, service string, client *aiven.Client) (err error) {
 	var acls []*aiven.KafkaACL
 	if acls, err = client.KafkaACLs.List(project, service); err == nil {
 		for _, acl := range acls {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -158,7 +159,7 @@ func resourceKafkaConnectorRead(ctx context.Context, d *schema.ResourceData, m i
 		Pending: []string{"IN_PROGRESS"},
 		Target:  []string{"OK"},
 		Refresh: func() (interface{}, string, error) {
-			list, err := m.(*aiven.Client).KafkaConnectors.List(project, serviceName)
+			list, err := m.(*common.ProviderMeta).Client.KafkaConnectors.List(project, serviceName)
 			if err != nil {
 				log.Printf("[DEBUG] Kafka Connectors list waiter err %s", err.Error())
 				if aiven.IsNotFound(err) {
@@ -238,7 +239,7 @@ func resourceKafkaConnectorCreate(ctx context.Context, d *schema.ResourceData, m
 		config[k] = cS.(string)
 	}
 
-	err := m.(*aiven.Client).KafkaConnectors.Create(project, serviceName, config)
+	err := m.(*common.ProviderMeta).Client.KafkaConnectors.Create(project, serviceName, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -254,7 +255,7 @@ func resourceKafkaConnectorDelete(_ context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	err = m.(*aiven.Client).KafkaConnectors.Delete(project, service, name)
+	err = m.(*common.ProviderMeta).Client.KafkaConnectors.Delete(project, service, name)
 	if err != nil && !aiven.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
@@ -273,7 +274,7 @@ func resourceKafkaTConnectorUpdate(ctx context.Context, d *schema.ResourceData,
 		config[k] = cS.(string)
 	}
 
-	_, err = m.(*aiven.Client).KafkaConnectors.Update(project, serviceName, connectorName, config)
+	_, err = m.(*common.ProviderMeta).Client.KafkaConnectors.Update(project, serviceName, connectorName, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
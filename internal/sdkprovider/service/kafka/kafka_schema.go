@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 )
@@ -104,7 +105,7 @@ func ResourceKafkaSchema() *schema.Resource {
 }
 
 func kafkaSchemaSubjectGetLastVersion(m interface{}, project, serviceName, subjectName string) (int, error) {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	r, err := client.KafkaSubjectSchemas.GetVersions(project, serviceName, subjectName)
 	if err != nil {
@@ -130,7 +131,7 @@ func resourceKafkaSchemaCreate(ctx context.Context, d *schema.ResourceData, m in
 	serviceName := d.Get("service_name").(string)
 	subjectName := d.Get("subject_name").(string)
 
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	// create Kafka Schema Subject
 	_, err := client.KafkaSubjectSchemas.Add(
@@ -180,7 +181,7 @@ func resourceKafkaSchemaUpdate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	if d.HasChange("schema") {
 		_, err := client.KafkaSubjectSchemas.Add(
@@ -219,7 +220,7 @@ func resourceKafkaSchemaRead(_ context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	version, err := kafkaSchemaSubjectGetLastVersion(m, project, serviceName, subjectName)
 	if err != nil {
@@ -270,7 +271,7 @@ func resourceKafkaSchemaDelete(_ context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	err = m.(*aiven.Client).KafkaSubjectSchemas.Delete(project, serviceName, schemaName)
+	err = m.(*common.ProviderMeta).Client.KafkaSubjectSchemas.Delete(project, serviceName, schemaName)
 	if err != nil && !aiven.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
@@ -279,7 +280,7 @@ func resourceKafkaSchemaDelete(_ context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceKafkaSchemaCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	// no previous version: allow the diff, nothing to check compatibility against
 	if _, ok := d.GetOk("version"); !ok {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -61,7 +62,7 @@ func resourceKafkaSchemaConfigurationUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	_, err = m.(*aiven.Client).KafkaGlobalSchemaConfig.Update(
+	_, err = m.(*common.ProviderMeta).Client.KafkaGlobalSchemaConfig.Update(
 		project,
 		serviceName,
 		aiven.KafkaSchemaConfig{
@@ -79,7 +80,7 @@ func resourceKafkaSchemaConfigurationCreate(ctx context.Context, d *schema.Resou
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
 
-	_, err := m.(*aiven.Client).KafkaGlobalSchemaConfig.Update(
+	_, err := m.(*common.ProviderMeta).Client.KafkaGlobalSchemaConfig.Update(
 		project,
 		serviceName,
 		aiven.KafkaSchemaConfig{
@@ -100,7 +101,7 @@ func resourceKafkaSchemaConfigurationRead(_ context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	r, err := m.(*aiven.Client).KafkaGlobalSchemaConfig.Get(project, serviceName)
+	r, err := m.(*common.ProviderMeta).Client.KafkaGlobalSchemaConfig.Get(project, serviceName)
 	if err != nil {
 		return diag.FromErr(schemautil.ResourceReadHandleNotFound(err, d))
 	}
@@ -126,7 +127,7 @@ func resourceKafkaSchemaConfigurationDelete(_ context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	_, err = m.(*aiven.Client).KafkaGlobalSchemaConfig.Update(
+	_, err = m.(*common.ProviderMeta).Client.KafkaGlobalSchemaConfig.Update(
 		project,
 		serviceName,
 		aiven.KafkaSchemaConfig{
@@ -3,7 +3,7 @@ package kafka
 import (
 	"context"
 
-	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,7 +23,7 @@ func datasourceKafkaSchemasConfigurationRead(ctx context.Context, d *schema.Reso
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
 
-	_, err := m.(*aiven.Client).KafkaGlobalSchemaConfig.Get(projectName, serviceName)
+	_, err := m.(*common.ProviderMeta).Client.KafkaGlobalSchemaConfig.Get(projectName, serviceName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,7 +64,7 @@ func ResourceKafkaSchemaRegistryACL() *schema.Resource {
 }
 
 func resourceKafkaSchemaRegistryACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -87,7 +88,7 @@ func resourceKafkaSchemaRegistryACLCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceKafkaSchemaRegistryACLRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, aclID, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -108,7 +109,7 @@ func resourceKafkaSchemaRegistryACLRead(_ context.Context, d *schema.ResourceDat
 }
 
 func resourceKafkaSchemaRegistryACLDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, aclID, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
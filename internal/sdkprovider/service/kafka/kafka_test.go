@@ -8,6 +8,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	acctest3 "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -75,7 +76,7 @@ func TestAccAiven_kafka(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "service_port"),
 					resource.TestCheckResourceAttrSet(resourceName, "service_uri"),
 					func(state *terraform.State) error {
-						c := acctest3.TestAccProvider.Meta().(*aiven.Client)
+						c := acctest3.TestAccProvider.Meta().(*common.ProviderMeta).Client
 						a, err := c.KafkaACLs.List(os.Getenv("AIVEN_PROJECT_NAME"), rName2)
 						if err != nil && !aiven.IsNotFound(err) {
 							return fmt.Errorf("cannot get a list of kafka ACLs: %s", err)
@@ -9,10 +9,14 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader"
@@ -44,6 +48,7 @@ var aivenKafkaTopicSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "It is a Terraform client-side deletion protection, which prevents a Kafka topic from being deleted. It is recommended to enable this for any production Kafka topic containing critical data.",
 	},
+	"tags_all": schemautil.CommonSchemaTagsAll,
 	"tag": {
 		Type:        schema.TypeSet,
 		Description: "Kafka Topic tag.",
@@ -224,8 +229,6 @@ var aivenKafkaTopicSchema = map[string]*schema.Schema{
 }
 
 func ResourceKafkaTopic() *schema.Resource {
-	initTopicCache()
-
 	return &schema.Resource{
 		Description:   "The Kafka Topic resource allows the creation and management of Aiven Kafka Topics.",
 		CreateContext: resourceKafkaTopicCreate,
@@ -239,26 +242,31 @@ func ResourceKafkaTopic() *schema.Resource {
 		Schema:         aivenKafkaTopicSchema,
 		SchemaVersion:  1,
 		StateUpgraders: stateupgrader.KafkaTopic(),
-		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
-			oldPartitions, newPartitions := d.GetChange("partitions")
+		CustomizeDiff: customdiff.Sequence(
+			schemautil.CustomizeDiffTagsAll,
+			customizeDiffKafkaTopicPartitions,
+		),
+	}
+}
 
-			assertedOldPartitions, ok := oldPartitions.(int)
-			if !ok {
-				return nil
-			}
+func customizeDiffKafkaTopicPartitions(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	oldPartitions, newPartitions := d.GetChange("partitions")
 
-			assertedNewPartitions, ok := newPartitions.(int)
-			if !ok {
-				return nil
-			}
+	assertedOldPartitions, ok := oldPartitions.(int)
+	if !ok {
+		return nil
+	}
 
-			if assertedOldPartitions > assertedNewPartitions {
-				return errors.New("number of partitions cannot be decreased")
-			}
+	assertedNewPartitions, ok := newPartitions.(int)
+	if !ok {
+		return nil
+	}
 
-			return nil
-		},
+	if assertedOldPartitions > assertedNewPartitions {
+		return errors.New("number of partitions cannot be decreased")
 	}
+
+	return nil
 }
 
 func resourceKafkaTopicCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -273,11 +281,11 @@ func resourceKafkaTopicCreate(ctx context.Context, d *schema.ResourceData, m int
 		Replication: &replication,
 		TopicName:   topicName,
 		Config:      getKafkaTopicConfig(d),
-		Tags:        getTags(d),
+		Tags:        getTags(d, m),
 	}
 
 	w := &kafkaTopicCreateWaiter{
-		Client:        m.(*aiven.Client),
+		Client:        m.(*common.ProviderMeta).Client,
 		Project:       project,
 		ServiceName:   serviceName,
 		CreateRequest: createRequest,
@@ -297,16 +305,19 @@ func resourceKafkaTopicCreate(ctx context.Context, d *schema.ResourceData, m int
 	return nil
 }
 
-func getTags(d *schema.ResourceData) []aiven.KafkaTopicTag {
-	var tags []aiven.KafkaTopicTag
-	for _, tagD := range d.Get("tag").(*schema.Set).List() {
-		tagM := tagD.(map[string]interface{})
-		tag := aiven.KafkaTopicTag{
-			Key:   tagM["key"].(string),
-			Value: tagM["value"].(string),
-		}
+// getTags returns the topic tags merged with the provider default tags, sorted by key.
+func getTags(d *schema.ResourceData, m interface{}) []aiven.KafkaTopicTag {
+	t := schemautil.GetTagsFromSchema(d, m)
+
+	keys := maps.Keys(t)
+	slices.Sort(keys)
 
-		tags = append(tags, tag)
+	var tags []aiven.KafkaTopicTag
+	for _, k := range keys {
+		tags = append(tags, aiven.KafkaTopicTag{
+			Key:   k,
+			Value: t[k],
+		})
 	}
 
 	return tags
@@ -398,7 +409,7 @@ func resourceKafkaTopicRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("tag", flattenKafkaTopicTags(topic.Tags)); err != nil {
+	if err := schemautil.SetTags(d, m, flattenKafkaTopicTags(topic.Tags)); err != nil {
 		return diag.Errorf("error setting Kafka Topic Tags for resource %s: %s", d.Id(), err)
 	}
 
@@ -413,13 +424,10 @@ func resourceKafkaTopicReadDatasource(ctx context.Context, d *schema.ResourceDat
 	return resourceKafkaTopicRead(ctx, d, m, false)
 }
 
-func flattenKafkaTopicTags(list []aiven.KafkaTopicTag) []map[string]interface{} {
-	var tags []map[string]interface{}
+func flattenKafkaTopicTags(list []aiven.KafkaTopicTag) map[string]string {
+	tags := make(map[string]string, len(list))
 	for _, tagS := range list {
-		tags = append(tags, map[string]interface{}{
-			"key":   tagS.Key,
-			"value": tagS.Value,
-		})
+		tags[tagS.Key] = tagS.Value
 	}
 
 	return tags
@@ -431,12 +439,7 @@ func getTopic(ctx context.Context, d *schema.ResourceData, m interface{}) (*aive
 		return nil, err
 	}
 
-	client, ok := m.(*aiven.Client)
-	if !ok {
-		return nil, fmt.Errorf("invalid Aiven client")
-	}
-
-	w, err := newKafkaTopicAvailabilityWaiter(client, project, serviceName, topicName)
+	w, err := newKafkaTopicAvailabilityWaiter(m, project, serviceName, topicName)
 	if err != nil {
 		return nil, err
 	}
@@ -455,7 +458,7 @@ func getTopic(ctx context.Context, d *schema.ResourceData, m interface{}) (*aive
 }
 
 func resourceKafkaTopicUpdate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	partitions := d.Get("partitions").(int)
 	projectName, serviceName, topicName, err := schemautil.SplitResourceID3(d.Id())
@@ -471,7 +474,7 @@ func resourceKafkaTopicUpdate(_ context.Context, d *schema.ResourceData, m inter
 			Partitions:  &partitions,
 			Replication: schemautil.OptionalIntPointer(d, "replication"),
 			Config:      getKafkaTopicConfig(d),
-			Tags:        getTags(d),
+			Tags:        getTags(d, m),
 		},
 	)
 	if err != nil {
@@ -482,7 +485,7 @@ func resourceKafkaTopicUpdate(_ context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceKafkaTopicDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, topicName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
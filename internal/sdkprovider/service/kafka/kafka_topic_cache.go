@@ -6,39 +6,50 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	"golang.org/x/exp/slices"
-)
+	"golang.org/x/sync/semaphore"
 
-var (
-	once       sync.Once
-	topicCache *kafkaTopicCache
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
+// topicCacheKey is the key of the Kafka Topic Cache in the provider meta
+type topicCacheKey struct{}
+
+// serviceKey identifies a service in the caches, unlike concatenated names it cannot collide
+type serviceKey struct {
+	project string
+	service string
+}
+
 // kafkaTopicCache represents Kafka Topics cache based on Service and Project identifiers
 type kafkaTopicCache struct {
 	sync.RWMutex
-	internal map[string]map[string]aiven.KafkaTopic
-	inQueue  map[string][]string
-	missing  map[string][]string
-	v1list   map[string][]string
+	internal map[serviceKey]map[string]aiven.KafkaTopic
+	inQueue  map[serviceKey][]string
+	missing  map[serviceKey][]string
+	v1list   map[serviceKey][]string
+
+	// refreshSem allows a single refresh of the cache at a time
+	refreshSem *semaphore.Weighted
 }
 
-// initTopicCache creates new global instance of Kafka Topic Cache
-func initTopicCache() {
+// newTopicCache creates new instance of Kafka Topic Cache
+func newTopicCache() *kafkaTopicCache {
 	log.Print("[DEBUG] Creating an instance of kafkaTopicCache ...")
 
-	once.Do(func() {
-		topicCache = &kafkaTopicCache{
-			internal: make(map[string]map[string]aiven.KafkaTopic),
-			inQueue:  make(map[string][]string),
-			missing:  make(map[string][]string),
-			v1list:   make(map[string][]string),
-		}
-	})
+	return &kafkaTopicCache{
+		internal:   make(map[serviceKey]map[string]aiven.KafkaTopic),
+		inQueue:    make(map[serviceKey][]string),
+		missing:    make(map[serviceKey][]string),
+		v1list:     make(map[serviceKey][]string),
+		refreshSem: semaphore.NewWeighted(1),
+	}
 }
 
-// getTopicCache gets a global Kafka Topics Cache
-func getTopicCache() *kafkaTopicCache {
-	return topicCache
+// getTopicCache gets the Kafka Topics Cache of the provider instance
+func getTopicCache(m interface{}) *kafkaTopicCache {
+	return m.(*common.ProviderMeta).Cache(topicCacheKey{}, func() interface{} {
+		return newTopicCache()
+	}).(*kafkaTopicCache)
 }
 
 // LoadByProjectAndServiceName returns a list of Kafka Topics stored in the cache for a given Project
@@ -46,7 +57,7 @@ func getTopicCache() *kafkaTopicCache {
 // The ok result indicates whether value was found in the map.
 func (t *kafkaTopicCache) LoadByProjectAndServiceName(projectName, serviceName string) (map[string]aiven.KafkaTopic, bool) {
 	t.RLock()
-	result, ok := t.internal[projectName+serviceName]
+	result, ok := t.internal[serviceKey{projectName, serviceName}]
 	t.RUnlock()
 
 	return result, ok
@@ -59,7 +70,7 @@ func (t *kafkaTopicCache) LoadByTopicName(projectName, serviceName, topicName st
 	t.RLock()
 	defer t.RUnlock()
 
-	topics, ok := t.internal[projectName+serviceName]
+	topics, ok := t.internal[serviceKey{projectName, serviceName}]
 	if !ok {
 		return aiven.KafkaTopic{State: "CONFIGURING"}, false
 	}
@@ -78,7 +89,7 @@ func (t *kafkaTopicCache) LoadByTopicName(projectName, serviceName, topicName st
 // and Service names.
 func (t *kafkaTopicCache) DeleteByProjectAndServiceName(projectName, serviceName string) {
 	t.Lock()
-	delete(t.internal, projectName+serviceName)
+	delete(t.internal, serviceKey{projectName, serviceName})
 	t.Unlock()
 }
 
@@ -88,19 +99,21 @@ func (t *kafkaTopicCache) StoreByProjectAndServiceName(projectName, serviceName
 		return
 	}
 
+	key := serviceKey{projectName, serviceName}
+
 	log.Printf("[DEBUG] Updating Kafka Topic cache for project %s and service %s ...", projectName, serviceName)
 
 	for _, topic := range list {
 		t.Lock()
-		if _, ok := t.internal[projectName+serviceName]; !ok {
-			t.internal[projectName+serviceName] = make(map[string]aiven.KafkaTopic)
+		if _, ok := t.internal[key]; !ok {
+			t.internal[key] = make(map[string]aiven.KafkaTopic)
 		}
-		t.internal[projectName+serviceName][topic.TopicName] = *topic
+		t.internal[key][topic.TopicName] = *topic
 
 		// when topic is added to cache, it need to be deleted from the queue
-		for i, name := range t.inQueue[projectName+serviceName] {
+		for i, name := range t.inQueue[key] {
 			if name == topic.TopicName {
-				t.inQueue[projectName+serviceName] = append(t.inQueue[projectName+serviceName][:i], t.inQueue[projectName+serviceName][i+1:]...)
+				t.inQueue[key] = append(t.inQueue[key][:i], t.inQueue[key][i+1:]...)
 			}
 		}
 
@@ -110,34 +123,38 @@ func (t *kafkaTopicCache) StoreByProjectAndServiceName(projectName, serviceName
 
 // AddToQueue adds a topic name to a queue of topics to be found
 func (t *kafkaTopicCache) AddToQueue(projectName, serviceName, topicName string) {
+	key := serviceKey{projectName, serviceName}
+
 	var isFound bool
 
 	t.Lock()
 	// check if topic is already in the queue
-	for _, name := range t.inQueue[projectName+serviceName] {
+	for _, name := range t.inQueue[key] {
 		if name == topicName {
 			isFound = true
 		}
 	}
 
-	_, inCache := t.internal[projectName+serviceName][topicName]
+	_, inCache := t.internal[key][topicName]
 	// the only topic that is not in the queue nor inside cache can be added to the queue
 	if !isFound && !inCache {
-		t.inQueue[projectName+serviceName] = append(t.inQueue[projectName+serviceName], topicName)
+		t.inQueue[key] = append(t.inQueue[key], topicName)
 	}
 	t.Unlock()
 }
 
 // DeleteFromQueueAndMarkMissing topic from the queue and marks it as missing
 func (t *kafkaTopicCache) DeleteFromQueueAndMarkMissing(projectName, serviceName, topicName string) {
+	key := serviceKey{projectName, serviceName}
+
 	t.Lock()
-	for k, name := range t.inQueue[projectName+serviceName] {
+	for k, name := range t.inQueue[key] {
 		if name == topicName {
-			t.inQueue[projectName+serviceName] = slices.Delete(t.inQueue[projectName+serviceName], k, k+1)
+			t.inQueue[key] = slices.Delete(t.inQueue[key], k, k+1)
 		}
 	}
 
-	t.missing[projectName+serviceName] = append(t.missing[projectName+serviceName], topicName)
+	t.missing[key] = append(t.missing[key], topicName)
 	t.Unlock()
 }
 
@@ -146,24 +163,26 @@ func (t *kafkaTopicCache) GetMissing(projectName, serviceName string) []string {
 	t.RLock()
 	defer t.RUnlock()
 
-	return t.missing[projectName+serviceName]
+	return t.missing[serviceKey{projectName, serviceName}]
 }
 
 // GetQueue retrieves a topics queue, retrieves up to 100 first elements
 func (t *kafkaTopicCache) GetQueue(projectName, serviceName string) []string {
+	key := serviceKey{projectName, serviceName}
+
 	t.RLock()
 	defer t.RUnlock()
 
-	if len(t.inQueue[projectName+serviceName]) >= 100 {
-		return t.inQueue[projectName+serviceName][:99]
+	if len(t.inQueue[key]) >= 100 {
+		return t.inQueue[key][:99]
 	}
 
-	return t.inQueue[projectName+serviceName]
+	return t.inQueue[key]
 }
 
 // FlushTopicCache for tests only!
-func FlushTopicCache() {
-	c := getTopicCache()
+func FlushTopicCache(m interface{}) {
+	c := getTopicCache(m)
 	c.Lock()
 	for k := range c.internal {
 		delete(c.internal, k)
@@ -176,9 +195,11 @@ func FlushTopicCache() {
 
 // SetV1List sets v1 topics list
 func (t *kafkaTopicCache) SetV1List(projectName, serviceName string, list []*aiven.KafkaListTopic) {
+	key := serviceKey{projectName, serviceName}
+
 	t.Lock()
 	for _, v := range list {
-		t.v1list[projectName+serviceName] = append(t.v1list[projectName+serviceName], v.TopicName)
+		t.v1list[key] = append(t.v1list[key], v.TopicName)
 	}
 	t.Unlock()
 }
@@ -188,5 +209,5 @@ func (t *kafkaTopicCache) GetV1List(projectName, serviceName string) []string {
 	t.RLock()
 	defer t.RUnlock()
 
-	return t.v1list[projectName+serviceName]
+	return t.v1list[serviceKey{projectName, serviceName}]
 }
@@ -5,52 +5,66 @@ import (
 	"testing"
 
 	"github.com/aiven/aiven-go-client"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
+// testMeta is the provider meta holding the Kafka Topic Cache under test
+var testMeta = common.NewProviderMeta(nil, nil)
+
 func setupTopicCacheTestCase(t *testing.T) func(t *testing.T) {
 	t.Log("setup Kafka Topic Cache test case")
 
-	if getTopicCache() == nil {
-		initTopicCache()
-	}
-
 	return func(t *testing.T) {
 		t.Log("teardown Kafka Topic Cache test case")
 
 		// clean topic cache after each test
-		topicCache.internal = make(map[string]map[string]aiven.KafkaTopic)
+		getTopicCache(testMeta).internal = make(map[serviceKey]map[string]aiven.KafkaTopic)
 	}
 }
 
 func TestGetTopicCache(t *testing.T) {
 	tests := []struct {
 		name string
-		init func()
+		meta *common.ProviderMeta
 		want *kafkaTopicCache
 	}{
 		{
 			"not_initialized",
-			func() {
-			},
-			&kafkaTopicCache{
-				internal: make(map[string]map[string]aiven.KafkaTopic),
-				inQueue:  make(map[string][]string),
-				missing:  make(map[string][]string),
-				v1list:   make(map[string][]string),
-			},
+			common.NewProviderMeta(nil, nil),
+			newTopicCache(),
 		},
 	}
 	for _, tt := range tests {
-		tt.init()
-
 		t.Run(tt.name, func(t *testing.T) {
-			if got := getTopicCache(); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("getTopicCache() = %v, want %v", got, tt.want)
+			if got := getTopicCache(tt.meta); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getTopicCache(testMeta) = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestGetTopicCache_PerProviderInstance(t *testing.T) {
+	meta := common.NewProviderMeta(nil, nil)
+
+	if getTopicCache(meta) != getTopicCache(meta) {
+		t.Error("getTopicCache(testMeta) returned different caches for the same provider instance")
+	}
+
+	if getTopicCache(meta) == getTopicCache(common.NewProviderMeta(nil, nil)) {
+		t.Error("getTopicCache(testMeta) returned the same cache for different provider instances")
+	}
+}
+
+func TestTopicCache_KeysDoNotCollide(t *testing.T) {
+	c := newTopicCache()
+	c.StoreByProjectAndServiceName("ab", "c", []*aiven.KafkaTopic{{TopicName: "topic-1", State: "ACTIVE"}})
+
+	if _, ok := c.LoadByTopicName("a", "bc", "topic-1"); ok {
+		t.Error("LoadByTopicName() found a topic of another project and service")
+	}
+}
+
 func TestTopicCache_LoadByProjectAndServiceName(t1 *testing.T) {
 	tearDown := setupTopicCacheTestCase(t1)
 	defer tearDown(t1)
@@ -99,7 +113,7 @@ func TestTopicCache_LoadByProjectAndServiceName(t1 *testing.T) {
 			true,
 		},
 	}
-	t := getTopicCache()
+	t := getTopicCache(testMeta)
 	for _, tt := range tests {
 		tt.doSomething()
 
@@ -162,7 +176,7 @@ func TestTopicCache_LoadByTopicName(t1 *testing.T) {
 			true,
 		},
 	}
-	t := getTopicCache()
+	t := getTopicCache(testMeta)
 	for _, tt := range tests {
 		tt.doSomething()
 
@@ -200,7 +214,7 @@ func TestTopicCache_DeleteByProjectAndServiceName(t1 *testing.T) {
 			},
 		},
 	}
-	t := getTopicCache()
+	t := getTopicCache(testMeta)
 	for _, tt := range tests {
 		tt.doSomething()
 
@@ -227,7 +241,7 @@ func TestTopicCache_DeleteByProjectAndServiceName(t1 *testing.T) {
 }
 
 func testAddTwoTopicsToCache() {
-	cache := getTopicCache()
+	cache := getTopicCache(testMeta)
 	cache.StoreByProjectAndServiceName(
 		"test-pr1",
 		"test-sr1",
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/sdkprovider/service/kafka"
 )
@@ -285,7 +286,7 @@ func testAccCheckAivenKafkaTopicAttributes(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAivenKafkaTopicResourceDestroy(s *terraform.State) error {
-	c := acc.TestAccProvider.Meta().(*aiven.Client)
+	c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 	// loop through the resources in state, verifying each kafka topic is destroyed
 	for _, rs := range s.RootModule().Resources {
@@ -376,8 +377,10 @@ func TestAccAivenKafkaTopic_recreate_missing(t *testing.T) {
 			{
 				// Step 2: deletes topic, then runs apply, same config & checks
 				PreConfig: func() {
-					client, ok := acc.TestAccProvider.Meta().(*aiven.Client)
-					assert.True(t, ok, "invalid aiven client")
+					meta, ok := acc.TestAccProvider.Meta().(*common.ProviderMeta)
+					assert.True(t, ok, "invalid provider meta")
+
+					client := meta.Client
 
 					// deletes
 					err := client.KafkaTopics.Delete(project, kafkaName, topicName)
@@ -389,7 +392,7 @@ func TestAccAivenKafkaTopic_recreate_missing(t *testing.T) {
 					assert.True(t, aiven.IsNotFound(err))
 
 					// We use cache for topics
-					kafka.FlushTopicCache()
+					kafka.FlushTopicCache(meta)
 				},
 				// Everything should be fine
 				ExpectNonEmptyPlan: true,
@@ -400,8 +403,10 @@ func TestAccAivenKafkaTopic_recreate_missing(t *testing.T) {
 					resource.TestCheckResourceAttr(topicResource, "id", topicID),
 					func(state *terraform.State) error {
 						// Topic exists and active
-						client, ok := acc.TestAccProvider.Meta().(*aiven.Client)
-						assert.True(t, ok, "invalid aiven client")
+						meta, ok := acc.TestAccProvider.Meta().(*common.ProviderMeta)
+						assert.True(t, ok, "invalid provider meta")
+
+						client := meta.Client
 
 						// Sometimes it gets 501
 						return retry.Do(func() error {
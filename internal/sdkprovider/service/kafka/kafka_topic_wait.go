@@ -8,26 +8,27 @@ import (
 	"github.com/aiven/aiven-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"golang.org/x/exp/slices"
-	"golang.org/x/sync/semaphore"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 )
 
 // kafkaTopicAvailabilityWaiter is used to refresh the Aiven Kafka Topic endpoints when
 // provisioning.
 type kafkaTopicAvailabilityWaiter struct {
 	Client      *aiven.Client
+	Cache       *kafkaTopicCache
 	Project     string
 	ServiceName string
 	TopicName   string
 }
 
-var kafkaTopicAvailabilitySem = semaphore.NewWeighted(1)
-
-func newKafkaTopicAvailabilityWaiter(client *aiven.Client, project, serviceName, topicName string) (*kafkaTopicAvailabilityWaiter, error) {
+func newKafkaTopicAvailabilityWaiter(m interface{}, project, serviceName, topicName string) (*kafkaTopicAvailabilityWaiter, error) {
 	if len(project)*len(serviceName)*len(topicName) == 0 {
 		return nil, fmt.Errorf("return invalid input: project=%q, serviceName=%q, topicName=%q", project, serviceName, topicName)
 	}
 	return &kafkaTopicAvailabilityWaiter{
-		Client:      client,
+		Client:      m.(*common.ProviderMeta).Client,
+		Cache:       getTopicCache(m),
 		Project:     project,
 		ServiceName: serviceName,
 		TopicName:   topicName,
@@ -38,7 +39,7 @@ func newKafkaTopicAvailabilityWaiter(client *aiven.Client, project, serviceName,
 // nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
 func (w *kafkaTopicAvailabilityWaiter) RefreshFunc() resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		cache := getTopicCache()
+		cache := w.Cache
 
 		// Caching a list of all topics for a service from v1 GET endpoint.
 		// Aiven has a request-per-minute limit; therefore, to minimize
@@ -88,13 +89,13 @@ func (w *kafkaTopicAvailabilityWaiter) RefreshFunc() resource.StateRefreshFunc {
 }
 
 func (w *kafkaTopicAvailabilityWaiter) refresh() error {
-	if !kafkaTopicAvailabilitySem.TryAcquire(1) {
+	if !w.Cache.refreshSem.TryAcquire(1) {
 		log.Printf("[TRACE] Kafka Topic Availability cache refresh already in progress ...")
 		return nil
 	}
-	defer kafkaTopicAvailabilitySem.Release(1)
+	defer w.Cache.refreshSem.Release(1)
 
-	c := getTopicCache()
+	c := w.Cache
 
 	// check if topic is already in cache
 	if _, ok := c.LoadByTopicName(w.Project, w.ServiceName, w.TopicName); ok {
@@ -127,7 +128,7 @@ func (w *kafkaTopicAvailabilityWaiter) refresh() error {
 			return err
 		}
 
-		getTopicCache().StoreByProjectAndServiceName(w.Project, w.ServiceName, v2Topics)
+		c.StoreByProjectAndServiceName(w.Project, w.ServiceName, v2Topics)
 	}
 
 	return nil
@@ -4,7 +4,6 @@ import (
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/dist"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,7 +14,47 @@ func aivenM3DBSchema() map[string]*schema.Schema {
 		Computed:    true,
 		Description: "M3 specific server provided values",
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{},
+			Schema: map[string]*schema.Schema{
+				"uris": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "M3DB server URIs",
+					Sensitive:   true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"http_cluster_uri": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "M3DB HTTP cluster URI",
+					Sensitive:   true,
+				},
+				"http_node_uri": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "M3DB HTTP node URI",
+					Sensitive:   true,
+				},
+				"influxdb_uri": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "M3DB InfluxDB URI",
+					Sensitive:   true,
+				},
+				"prometheus_remote_read_uri": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "M3DB Prometheus remote read URI",
+					Sensitive:   true,
+				},
+				"prometheus_remote_write_uri": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "M3DB Prometheus remote write URI",
+					Sensitive:   true,
+				},
+			},
 		},
 	}
 	schemaM3[schemautil.ServiceTypeM3+"_user_config"] = dist.ServiceTypeM3db()
@@ -29,37 +68,14 @@ func ResourceM3DB() *schema.Resource {
 		ReadContext:   schemautil.ResourceServiceRead,
 		UpdateContext: schemautil.ResourceServiceUpdate,
 		DeleteContext: schemautil.ResourceServiceDelete,
-		CustomizeDiff: customdiff.Sequence(
-			schemautil.SetServiceTypeIfEmpty(schemautil.ServiceTypeM3),
-			schemautil.CustomizeDiffDisallowMultipleManyToOneKeys,
-			customdiff.IfValueChange("tag",
-				schemautil.TagsShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckUniqueTag,
-			),
-			customdiff.IfValueChange("disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("additional_disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("service_integrations",
-				schemautil.ServiceIntegrationShouldNotBeEmpty,
-				schemautil.CustomizeDiffServiceIntegrationAfterCreation,
-			),
-			customdiff.Sequence(
-				schemautil.CustomizeDiffCheckPlanAndStaticIpsCannotBeModifiedTogether,
-				schemautil.CustomizeDiffCheckStaticIPDisassociation,
-			),
-		),
+		CustomizeDiff: schemautil.CustomizeDiffGenericService(schemautil.ServiceTypeM3),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: schemautil.DefaultResourceTimeouts(),
 
 		Schema:         aivenM3DBSchema(),
-		SchemaVersion:  1,
-		StateUpgraders: stateupgrader.M3DB(),
+		SchemaVersion:  2,
+		StateUpgraders: append(stateupgrader.M3DB(), stateupgrader.DiskSpace(1, aivenM3DBSchema())),
 	}
 }
@@ -4,7 +4,6 @@ import (
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/dist"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/stateupgrader"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,10 +14,76 @@ func aivenMySQLSchema() map[string]*schema.Schema {
 		Computed:    true,
 		Description: "MySQL specific server provided values",
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{},
+			Schema: map[string]*schema.Schema{
+				"uris": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "MySQL primary connection URIs",
+					Sensitive:   true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"standby_uris": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "MySQL standby connection URIs",
+					Sensitive:   true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"replica_uri": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "MySQL replica URI for services with a replica",
+					Sensitive:   true,
+				},
+				"params": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "MySQL connection parameters",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"host": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "MySQL host IP or name",
+							},
+							"port": {
+								Type:        schema.TypeInt,
+								Computed:    true,
+								Description: "MySQL port",
+							},
+							"database_name": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "Primary MySQL database name",
+							},
+							"user": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "MySQL admin user name",
+							},
+							"password": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "MySQL admin user password",
+								Sensitive:   true,
+							},
+							"sslmode": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "MySQL sslmode setting (currently always \"require\")",
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 	schemaMySQL[schemautil.ServiceTypeMySQL+"_user_config"] = dist.ServiceTypeMysql()
+	schemaMySQL["promote_to_primary"] = schemautil.PromoteToPrimarySchema()
 
 	return schemaMySQL
 }
@@ -29,37 +94,14 @@ func ResourceMySQL() *schema.Resource {
 		ReadContext:   schemautil.ResourceServiceRead,
 		UpdateContext: schemautil.ResourceServiceUpdate,
 		DeleteContext: schemautil.ResourceServiceDelete,
-		CustomizeDiff: customdiff.Sequence(
-			schemautil.SetServiceTypeIfEmpty(schemautil.ServiceTypeMySQL),
-			schemautil.CustomizeDiffDisallowMultipleManyToOneKeys,
-			customdiff.IfValueChange("tag",
-				schemautil.TagsShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckUniqueTag,
-			),
-			customdiff.IfValueChange("disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("additional_disk_space",
-				schemautil.DiskSpaceShouldNotBeEmpty,
-				schemautil.CustomizeDiffCheckDiskSpace,
-			),
-			customdiff.IfValueChange("service_integrations",
-				schemautil.ServiceIntegrationShouldNotBeEmpty,
-				schemautil.CustomizeDiffServiceIntegrationAfterCreation,
-			),
-			customdiff.Sequence(
-				schemautil.CustomizeDiffCheckPlanAndStaticIpsCannotBeModifiedTogether,
-				schemautil.CustomizeDiffCheckStaticIPDisassociation,
-			),
-		),
+		CustomizeDiff: schemautil.CustomizeDiffGenericService(schemautil.ServiceTypeMySQL),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: schemautil.DefaultResourceTimeouts(),
 
 		Schema:         aivenMySQLSchema(),
-		SchemaVersion:  1,
-		StateUpgraders: stateupgrader.MySQL(),
+		SchemaVersion:  2,
+		StateUpgraders: append(stateupgrader.MySQL(), stateupgrader.DiskSpace(1, aivenMySQLSchema())),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -44,7 +45,7 @@ func ResourceMySQLDatabase() *schema.Resource {
 }
 
 func resourceMySQLDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -72,7 +73,7 @@ func resourceMySQLDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceMySQLDatabaseRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -98,7 +99,7 @@ func resourceMySQLDatabaseRead(_ context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceMySQLDatabaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -71,7 +72,7 @@ func ResourcePGDatabase() *schema.Resource {
 }
 
 func resourcePGDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	serviceName := d.Get("service_name").(string)
@@ -99,7 +100,7 @@ func resourcePGDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourcePGDatabaseRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -131,7 +132,7 @@ func resourcePGDatabaseRead(_ context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourcePGDatabaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, serviceName, databaseName, err := schemautil.SplitResourceID3(d.Id())
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
@@ -948,7 +949,7 @@ func testAccCheckAivenServiceTerminationProtection(n string) resource.TestCheckF
 			return err
 		}
 
-		c := acctest3.TestAccProvider.Meta().(*aiven.Client)
+		c := acctest3.TestAccProvider.Meta().(*common.ProviderMeta).Client
 
 		service, err := c.Services.Get(projectName, serviceName)
 		if err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 )
@@ -126,7 +127,7 @@ func ResourceBillingGroup() *schema.Resource {
 }
 
 func resourceBillingGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	var billingEmails []*aiven.ContactEmail
 	if emails := contactEmailListForAPI(d, "billing_emails", true); emails != nil {
@@ -172,7 +173,7 @@ func resourceBillingGroupCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceBillingGroupRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	bg, err := client.BillingGroup.Get(d.Id())
 	if err != nil {
@@ -240,7 +241,7 @@ func resourceBillingGroupRead(_ context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceBillingGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	var billingEmails []*aiven.ContactEmail
 	if emails := contactEmailListForAPI(d, "billing_emails", true); emails != nil {
@@ -285,7 +286,7 @@ func resourceBillingGroupUpdate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceBillingGroupDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	err := client.BillingGroup.Delete(d.Id())
 	if err != nil && !aiven.IsNotFound(err) {
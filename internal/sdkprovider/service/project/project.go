@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 )
@@ -81,6 +82,7 @@ var aivenProjectSchema = map[string]*schema.Schema{
 		Description:      userconfig.Desc("The id of the billing group that is linked to this project.").Referenced().Build(),
 		DiffSuppressFunc: schemautil.EmptyObjectDiffSuppressFunc,
 	},
+	"tags_all": schemautil.CommonSchemaTagsAll,
 	"tag": {
 		Description: "Tags are key-value pairs that allow you to categorize projects.",
 		Type:        schema.TypeSet,
@@ -132,15 +134,18 @@ func ResourceProject() *schema.Resource {
 		Timeouts: schemautil.DefaultResourceTimeouts(),
 
 		Schema: aivenProjectSchema,
-		CustomizeDiff: customdiff.IfValueChange("tag",
-			schemautil.TagsShouldNotBeEmpty,
-			schemautil.CustomizeDiffCheckUniqueTag,
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.IfValueChange("tag",
+				schemautil.TagsShouldNotBeEmpty,
+				schemautil.CustomizeDiffCheckUniqueTag,
+			),
+			schemautil.CustomizeDiffTagsAll,
 		),
 	}
 }
 
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 
@@ -152,7 +157,7 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		UseSourceProjectBillingGroup: d.Get("use_source_project_billing_group").(bool),
 		BillingGroupId:               d.Get("billing_group").(string),
 		AddAccountOwnersAdminAccess:  schemautil.OptionalBoolPointer(d, "add_account_owners_admin_access"),
-		Tags:                         schemautil.GetTagsFromSchema(d),
+		Tags:                         schemautil.GetTagsFromSchema(d, m),
 	}
 
 	ptrAccountID, err := accountIDPointer(client, d)
@@ -243,7 +248,7 @@ func resourceProjectAssignToBillingGroup(
 
 // nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	conf := &resource.StateChangeConf{
 		Pending:    []string{"pending"},
@@ -267,11 +272,11 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	if err != nil {
 		return diag.FromErr(schemautil.ResourceReadHandleNotFound(err, d))
 	}
-	return setProjectTerraformProperties(d, client, project.(*aiven.Project))
+	return setProjectTerraformProperties(d, m, project.(*aiven.Project))
 }
 
 func resourceProjectUpdate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 
@@ -279,7 +284,7 @@ func resourceProjectUpdate(_ context.Context, d *schema.ResourceData, m interfac
 		Name:                        projectName,
 		Cloud:                       schemautil.OptionalStringPointer(d, "default_cloud"),
 		TechnicalEmails:             contactEmailListForAPI(d, "technical_emails", false),
-		Tags:                        schemautil.GetTagsFromSchema(d),
+		Tags:                        schemautil.GetTagsFromSchema(d, m),
 		AddAccountOwnersAdminAccess: schemautil.OptionalBoolPointer(d, "add_account_owners_admin_access"),
 	}
 
@@ -313,7 +318,7 @@ func resourceProjectUpdate(_ context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceProjectDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	err := client.Projects.Delete(d.Id())
 
@@ -399,7 +404,9 @@ func contactEmailListForTerraform(d *schema.ResourceData, field string, contactE
 	return d.Set(field, results)
 }
 
-func setProjectTerraformProperties(d *schema.ResourceData, client *aiven.Client, project *aiven.Project) diag.Diagnostics {
+func setProjectTerraformProperties(d *schema.ResourceData, m interface{}, project *aiven.Project) diag.Diagnostics {
+	client := m.(*common.ProviderMeta).Client
+
 	if stateID, _ := d.GetOk("owner_entity_id"); true {
 		idToSet, err := schemautil.DetermineMixedOrganizationConstraintIDToStore(
 			client,
@@ -442,7 +449,7 @@ func setProjectTerraformProperties(d *schema.ResourceData, client *aiven.Client,
 	if err := d.Set("billing_group", project.BillingGroupId); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("tag", schemautil.SetTagsTerraformProperties(project.Tags)); err != nil {
+	if err := schemautil.SetTags(d, m, project.Tags); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
@@ -49,7 +50,7 @@ func ResourceProjectUser() *schema.Resource {
 }
 
 func resourceProjectUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	projectName := d.Get("project").(string)
 	email := d.Get("email").(string)
 	err := client.ProjectUsers.Invite(
@@ -72,7 +73,7 @@ func resourceProjectUserCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceProjectUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, email, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -112,7 +113,7 @@ func resourceProjectUserRead(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceProjectUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, email, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -135,7 +136,7 @@ func resourceProjectUserUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceProjectUserDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, email, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -0,0 +1,156 @@
+package servicebackups
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func DatasourceServiceBackups() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Service Backups data source provides the backups of an Aiven service and the window of " +
+			"time they can be restored to, e.g. with the `restore_from` block of a new service.",
+		ReadContext: datasourceServiceBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"project":      schemautil.CommonSchemaProjectReference,
+			"service_name": schemautil.CommonSchemaServiceNameReference,
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Backups of the service, from the oldest to the latest.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Backup name",
+						},
+						"backup_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Backup timestamp (RFC3339)",
+						},
+						"data_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Backup size in bytes",
+						},
+						"storage_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Location of the backup",
+						},
+						"additional_regions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Other regions the backup is synchronized to",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloud": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Cloud name",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Cloud region",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"point_in_time_recovery": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the service can be recovered to any point in time of its backup window.",
+			},
+			"backup_window_start": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Oldest point in time the service can be restored to (RFC3339), empty without backups.",
+			},
+			"backup_window_end": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Latest point in time the service can be restored to (RFC3339), empty without backups. " +
+					"With point-in-time recovery, it is the time the data source was read.",
+			},
+		},
+	}
+}
+
+func datasourceServiceBackupsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.ProviderMeta).Client
+
+	projectName := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+
+	s, err := client.Services.Get(projectName, serviceName)
+	if err != nil {
+		return diag.Errorf("error getting service %s/%s: %s", projectName, serviceName, err)
+	}
+
+	d.SetId(schemautil.BuildResourceID(projectName, serviceName))
+
+	pitr := schemautil.SupportsPointInTimeRecovery(s.Type)
+
+	var start, end string
+	if w, ok := schemautil.ServiceBackupWindow(s, pitr, time.Now().UTC()); ok {
+		start, end = w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339)
+	}
+
+	if err := d.Set("backups", flattenBackups(s.Backups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("point_in_time_recovery", pitr); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("backup_window_start", start); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("backup_window_end", end); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// flattenBackups returns the backups value of the backups, sorted from the oldest to the latest.
+func flattenBackups(backups []*aiven.Backup) []map[string]interface{} {
+	r := make([]map[string]interface{}, 0, len(backups))
+
+	for _, b := range backups {
+		regions := make([]map[string]interface{}, 0, len(b.AdditionalRegions))
+		for _, ar := range b.AdditionalRegions {
+			regions = append(regions, map[string]interface{}{
+				"cloud":  ar.Cloud,
+				"region": ar.Region,
+			})
+		}
+
+		r = append(r, map[string]interface{}{
+			"backup_name":        b.BackupName,
+			"backup_time":        b.BackupTime,
+			"data_size":          b.DataSize,
+			"storage_location":   b.StorageLocation,
+			"additional_regions": regions,
+		})
+	}
+
+	// RFC3339 timestamps of the same offset sort lexically, the API returns UTC times
+	sort.SliceStable(r, func(i, j int) bool {
+		return r[i]["backup_time"].(string) < r[j]["backup_time"].(string)
+	})
+
+	return r
+}
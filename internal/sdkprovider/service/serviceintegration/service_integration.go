@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/apiconvert"
@@ -141,7 +142,7 @@ func plainEndpointID(fullEndpointID *string) *string {
 }
 
 func resourceServiceIntegrationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	integrationType := d.Get("integration_type").(string)
@@ -187,7 +188,7 @@ func resourceServiceIntegrationCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceServiceIntegrationRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, integrationID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -211,7 +212,7 @@ func resourceServiceIntegrationRead(_ context.Context, d *schema.ResourceData, m
 }
 
 func resourceServiceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, integrationID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -246,7 +247,7 @@ func resourceServiceIntegrationUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceServiceIntegrationDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, integrationID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -262,7 +263,7 @@ func resourceServiceIntegrationDelete(_ context.Context, d *schema.ResourceData,
 }
 
 func resourceServiceIntegrationCheckForPreexistingResource(_ context.Context, d *schema.ResourceData, m interface{}) (*aiven.ServiceIntegration, error) {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName := d.Get("project").(string)
 	integrationType := d.Get("integration_type").(string)
@@ -295,7 +296,7 @@ func resourceServiceIntegrationWaitUntilActive(ctx context.Context, d *schema.Re
 		active    = "ACTIVE"
 		notActive = "NOTACTIVE"
 	)
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, integrationID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
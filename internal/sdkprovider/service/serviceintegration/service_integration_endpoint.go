@@ -7,6 +7,7 @@ import (
 	"github.com/aiven/aiven-go-client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig/apiconvert"
@@ -90,7 +91,7 @@ func ResourceServiceIntegrationEndpoint() *schema.Resource {
 }
 
 func resourceServiceIntegrationEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	projectName := d.Get("project").(string)
 	endpointType := d.Get("endpoint_type").(string)
 
@@ -118,7 +119,7 @@ func resourceServiceIntegrationEndpointCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceServiceIntegrationEndpointRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, endpointID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -139,7 +140,7 @@ func resourceServiceIntegrationEndpointRead(_ context.Context, d *schema.Resourc
 }
 
 func resourceServiceIntegrationEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, endpointID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -168,7 +169,7 @@ func resourceServiceIntegrationEndpointUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceServiceIntegrationEndpointDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, endpointID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
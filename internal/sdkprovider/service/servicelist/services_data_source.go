@@ -0,0 +1,245 @@
+package servicelist
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/docker/go-units"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func DatasourceServices() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Services data source provides the services of a project, optionally filtered, e.g. to " +
+			"discover the services managed by other configurations.",
+		ReadContext: datasourceServicesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project name",
+			},
+			"service_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the services of this type, e.g. `pg`.",
+			},
+			"cloud_name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the services in a cloud starting with this prefix, e.g. `aws-` or `google-europe`.",
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Only the services in this state. The possible values are `POWEROFF`, `REBALANCING`, " +
+					"`REBUILDING` and `RUNNING`.",
+				ValidateFunc: validation.StringInSlice([]string{"POWEROFF", "REBALANCING", "REBUILDING", "RUNNING"}, false),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only the services with a name matching this regular expression.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"tag": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only the services with all these tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Service tag key",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Service tag value",
+						},
+					},
+				},
+			},
+			"services": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The services matching the filters, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Service name",
+						},
+						"service_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Service type",
+						},
+						"cloud_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cloud the service runs in",
+						},
+						"plan": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Service plan",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Service state",
+						},
+						"project_vpc_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "VPC the service runs in, empty if it does not run in a VPC",
+						},
+						"service_uri": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "URI for connecting to the service",
+						},
+						"disk_space_used": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Disk space allocated to the service",
+						},
+						"components": schemautil.ServiceCommonSchema()["components"],
+					},
+				},
+			},
+		},
+	}
+}
+
+// serviceFilter are the filters of the data source, the zero value matches all services.
+type serviceFilter struct {
+	serviceType     string
+	cloudNamePrefix string
+	state           string
+	nameRegex       *regexp.Regexp
+}
+
+// matches returns true if the service passes the filter.
+func (f serviceFilter) matches(s *aiven.Service) bool {
+	return (f.serviceType == "" || s.Type == f.serviceType) &&
+		strings.HasPrefix(s.CloudName, f.cloudNamePrefix) &&
+		(f.state == "" || s.State == f.state) &&
+		(f.nameRegex == nil || f.nameRegex.MatchString(s.Name))
+}
+
+// hasTags returns true if all the tags are in the service tags.
+func hasTags(serviceTags, tags map[string]string) bool {
+	for k, v := range tags {
+		if sv, ok := serviceTags[k]; !ok || sv != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func datasourceServicesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*common.ProviderMeta).Client
+
+	projectName := d.Get("project").(string)
+
+	f := serviceFilter{
+		serviceType:     d.Get("service_type").(string),
+		cloudNamePrefix: d.Get("cloud_name_prefix").(string),
+		state:           d.Get("state").(string),
+	}
+
+	if v := d.Get("name_regex").(string); v != "" {
+		r, err := regexp.Compile(v)
+		if err != nil {
+			return diag.Errorf("invalid name_regex: %s", err)
+		}
+		f.nameRegex = r
+	}
+
+	tags := make(map[string]string)
+	for _, t := range d.Get("tag").(*schema.Set).List() {
+		v := t.(map[string]interface{})
+		tags[v["key"].(string)] = v["value"].(string)
+	}
+
+	// the list may be cached before services created in the same apply
+	schemautil.InvalidateServiceList(m, projectName)
+
+	services, err := schemautil.ListServices(m, projectName)
+	if err != nil {
+		return diag.Errorf("error getting the services of project %s: %s", projectName, err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(services))
+
+	for _, s := range services {
+		if !f.matches(s) {
+			continue
+		}
+
+		// the service list has no tags, they are only fetched when filtering by tag
+		if len(tags) > 0 {
+			t, err := client.ServiceTags.Get(projectName, s.Name)
+			if err != nil {
+				return diag.Errorf("error getting the tags of service %s: %s", s.Name, err)
+			}
+
+			if !hasTags(t.Tags, tags) {
+				continue
+			}
+		}
+
+		result = append(result, flattenService(projectName, s))
+	}
+
+	sortServices(result)
+
+	d.SetId(projectName)
+
+	if err := d.Set("services", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// flattenService returns the services value of the service.
+func flattenService(projectName string, s *aiven.Service) map[string]interface{} {
+	var vpcID string
+	if s.ProjectVPCID != nil {
+		vpcID = schemautil.BuildResourceID(projectName, *s.ProjectVPCID)
+	}
+
+	return map[string]interface{}{
+		"service_name":    s.Name,
+		"service_type":    s.Type,
+		"cloud_name":      s.CloudName,
+		"plan":            s.Plan,
+		"state":           s.State,
+		"project_vpc_id":  vpcID,
+		"service_uri":     s.URI,
+		"disk_space_used": schemautil.HumanReadableByteSize(s.DiskSpaceMB * units.MiB),
+		"components":      schemautil.FlattenServiceComponents(s),
+	}
+}
+
+// sortServices sorts the services values by name.
+func sortServices(services []map[string]interface{}) {
+	sort.Slice(services, func(i, j int) bool {
+		return services[i]["service_name"].(string) < services[j]["service_name"].(string)
+	})
+}
@@ -0,0 +1,46 @@
+package servicelist
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceFilterMatches(t *testing.T) {
+	s := &aiven.Service{Name: "team-a-pg", Type: "pg", CloudName: "aws-eu-west-1", State: "RUNNING"}
+
+	tests := []struct {
+		name   string
+		filter serviceFilter
+		want   bool
+	}{
+		{name: "no filter", filter: serviceFilter{}, want: true},
+		{name: "all match", filter: serviceFilter{
+			serviceType:     "pg",
+			cloudNamePrefix: "aws-",
+			state:           "RUNNING",
+			nameRegex:       regexp.MustCompile("^team-a-"),
+		}, want: true},
+		{name: "other type", filter: serviceFilter{serviceType: "mysql"}, want: false},
+		{name: "other cloud", filter: serviceFilter{cloudNamePrefix: "google-"}, want: false},
+		{name: "other state", filter: serviceFilter{state: "POWEROFF"}, want: false},
+		{name: "other name", filter: serviceFilter{nameRegex: regexp.MustCompile("^team-b-")}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.matches(s))
+		})
+	}
+}
+
+func TestHasTags(t *testing.T) {
+	serviceTags := map[string]string{"team": "a", "env": "prod"}
+
+	assert.True(t, hasTags(serviceTags, map[string]string{}))
+	assert.True(t, hasTags(serviceTags, map[string]string{"team": "a"}))
+	assert.False(t, hasTags(serviceTags, map[string]string{"team": "b"}))
+	assert.False(t, hasTags(serviceTags, map[string]string{"owner": "a"}))
+}
@@ -0,0 +1,189 @@
+package serviceplan
+
+import (
+	"context"
+	"sort"
+
+	"github.com/docker/go-units"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+)
+
+func DatasourceServicePlans() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Service Plans data source provides the plans of a service type available in a project, " +
+			"with their parameters and prices in the clouds they are offered in.",
+		ReadContext: datasourceServicePlansRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project name",
+			},
+			"service_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service type, e.g. `pg`.",
+			},
+			"cloud_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the plans offered in this cloud, and only their parameters in this cloud.",
+			},
+			"service_plans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The plans of the service type, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_plan": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Plan name",
+						},
+						"node_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of nodes",
+						},
+						"backup_interval_hours": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Interval of the backups in hours",
+						},
+						"backup_max_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of backups kept",
+						},
+						"backup_recovery_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Recovery mode of the backups, e.g. `pitr` for point-in-time recovery",
+						},
+						"clouds": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Parameters of the plan in the clouds it is offered in, sorted by cloud name.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloud_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Cloud name",
+									},
+									"node_cpu_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "Number of CPUs of each node",
+									},
+									"node_memory": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Memory of each node",
+									},
+									"disk_space_default": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Disk space of the plan",
+									},
+									"disk_space_step": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Increment of the additional disk space, empty if not supported",
+									},
+									"disk_space_cap": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Maximum disk space, empty if additional disk space is not supported",
+									},
+									"price_usd": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Hourly price of the plan in USD",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceServicePlansRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectName := d.Get("project").(string)
+	serviceType := d.Get("service_type").(string)
+	cloudName := d.Get("cloud_name").(string)
+
+	plans, err := schemautil.ListServicePlans(ctx, m, projectName, serviceType)
+	if err != nil {
+		return diag.Errorf("error getting the %s service plans of project %s: %s", serviceType, projectName, err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(plans))
+
+	for _, p := range plans {
+		clouds := flattenServicePlanRegions(p.Regions, cloudName)
+		if cloudName != "" && len(clouds) == 0 {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"service_plan":          p.ServicePlan,
+			"node_count":            p.NodeCount,
+			"backup_interval_hours": p.BackupConfig.Interval,
+			"backup_max_count":      p.BackupConfig.MaxCount,
+			"backup_recovery_mode":  p.BackupConfig.RecoveryMode,
+			"clouds":                clouds,
+		})
+	}
+
+	d.SetId(schemautil.BuildResourceID(projectName, serviceType))
+
+	if err := d.Set("service_plans", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// flattenServicePlanRegions returns the clouds value of the plan regions, only the cloud if it is not empty.
+func flattenServicePlanRegions(regions map[string]*schemautil.ServicePlanRegion, cloudName string) []map[string]interface{} {
+	names := make([]string, 0, len(regions))
+	for name := range regions {
+		if cloudName == "" || name == cloudName {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	humanReadable := func(mb int) string {
+		if mb == 0 {
+			return ""
+		}
+		return schemautil.HumanReadableByteSize(mb * units.MiB)
+	}
+
+	r := make([]map[string]interface{}, 0, len(names))
+
+	for _, name := range names {
+		region := regions[name]
+
+		r = append(r, map[string]interface{}{
+			"cloud_name":         name,
+			"node_cpu_count":     region.NodeCPUCount,
+			"node_memory":        humanReadable(region.NodeMemoryMB),
+			"disk_space_default": humanReadable(region.DiskSpaceMB),
+			"disk_space_step":    humanReadable(region.DiskSpaceStepMB),
+			"disk_space_cap":     humanReadable(region.DiskSpaceCapMB),
+			"price_usd":          region.PriceUSD,
+		})
+	}
+
+	return r
+}
@@ -0,0 +1,79 @@
+package servicereadiness
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
+	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
+)
+
+func DatasourceServiceReadiness() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Service Readiness data source waits until an existing Aiven service reaches the given " +
+			"state. Use it with services created with `wait_for_state` set to `none` or `rebuilding_ok`, so that " +
+			"only the resources which need a ready service wait for it.",
+		ReadContext: datasourceServiceReadinessRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project":      schemautil.CommonSchemaProjectReference,
+			"service_name": schemautil.CommonSchemaServiceNameReference,
+			"wait_for_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  schemautil.WaitForStateRunning,
+				Description: userconfig.Desc("The state to wait for. With `running` the service must be running "+
+					"and ready to use, with `rebuilding_ok` the service may still be rebuilding.").
+					PossibleValues(schemautil.WaitForStateRunning, schemautil.WaitForStateRebuildingOK).
+					DefaultValue(schemautil.WaitForStateRunning).Build(),
+				ValidateFunc: validation.StringInSlice(
+					[]string{schemautil.WaitForStateRunning, schemautil.WaitForStateRebuildingOK}, false,
+				),
+			},
+			"readiness_checks": schemautil.ReadinessChecksSchema(),
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Service state once the wait is over.",
+			},
+		},
+	}
+}
+
+func datasourceServiceReadinessRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	projectName := d.Get("project").(string)
+	serviceName := d.Get("service_name").(string)
+
+	staticIps, err := schemautil.CurrentlyAllocatedStaticIps(ctx, projectName, serviceName, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s, err := schemautil.WaitForServiceState(
+		ctx,
+		m,
+		projectName,
+		serviceName,
+		staticIps,
+		d.Timeout(schema.TimeoutRead),
+		d.Get("wait_for_state").(string),
+		schemautil.ReadinessChecksFromSchema(d),
+	)
+	if err != nil {
+		return diag.Errorf("error waiting for service %s/%s: %s", projectName, serviceName, err)
+	}
+
+	d.SetId(schemautil.BuildResourceID(projectName, serviceName))
+
+	if err := d.Set("state", s.State); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
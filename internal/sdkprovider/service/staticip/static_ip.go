@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -59,7 +60,7 @@ func ResourceStaticIP() *schema.Resource {
 }
 
 func resourceStaticIPRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, staticIPAddressID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -88,7 +89,7 @@ func resourceStaticIPRead(_ context.Context, d *schema.ResourceData, m interface
 	return nil
 }
 func resourceStaticIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	cloudName := d.Get("cloud_name").(string)
@@ -108,7 +109,7 @@ func resourceStaticIPCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceStaticIPDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, staticIPAddressID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -144,7 +145,7 @@ func resourceStaticIPDelete(_ context.Context, d *schema.ResourceData, m interfa
 
 // nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
 func resourceStaticIPWait(ctx context.Context, d *schema.ResourceData, m interface{}) error {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, staticIPAddressID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aiven/aiven-go-client"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -51,7 +52,7 @@ func ResourceAWSPrivatelink() *schema.Resource {
 }
 
 func resourceAWSPrivatelinkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	var principals []string
 	var project = d.Get("project").(string)
@@ -72,7 +73,7 @@ func resourceAWSPrivatelinkCreate(ctx context.Context, d *schema.ResourceData, m
 
 	// Wait until the AWS privatelink is active
 	w := &AWSPrivatelinkWaiter{
-		Client:      m.(*aiven.Client),
+		Client:      m.(*common.ProviderMeta).Client,
 		Project:     project,
 		ServiceName: serviceName,
 	}
@@ -88,7 +89,7 @@ func resourceAWSPrivatelinkCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAWSPrivatelinkRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -119,7 +120,7 @@ func resourceAWSPrivatelinkRead(_ context.Context, d *schema.ResourceData, m int
 	return nil
 }
 func resourceAWSPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -142,7 +143,7 @@ func resourceAWSPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	// Wait until the AWS privatelink is active
 	w := &AWSPrivatelinkWaiter{
-		Client:      m.(*aiven.Client),
+		Client:      m.(*common.ProviderMeta).Client,
 		Project:     project,
 		ServiceName: serviceName,
 	}
@@ -156,7 +157,7 @@ func resourceAWSPrivatelinkUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAWSPrivatelinkDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	project, serviceName, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
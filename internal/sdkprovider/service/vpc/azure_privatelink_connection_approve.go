@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
@@ -83,7 +84,7 @@ func waitForConnectionState(_ context.Context, client *aiven.Client, project str
 }
 
 func resourcePrivatelinkConnectionApprovalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	var project = d.Get("project").(string)
 	var serviceName = d.Get("service_name").(string)
@@ -153,7 +154,7 @@ func resourcePrivatelinkConnectionApprovalUpdate(ctx context.Context, d *schema.
 }
 
 func resourcePrivatelinkConnectionApprovalRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	project, service, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -170,6 +171,14 @@ func resourcePrivatelinkConnectionApprovalRead(_ context.Context, d *schema.Reso
 		return diag.Errorf("Error getting Azure privatelink connection: %s", err)
 	}
 
+	if err := d.Set("project", project); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("service_name", service); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("privatelink_connection_id", plConnection.PrivatelinkConnectionID); err != nil {
 		return diag.FromErr(err)
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aiven/aiven-go-client"
 	acc "github.com/aiven/terraform-provider-aiven/internal/acctest"
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/kelseyhightower/envconfig"
@@ -53,7 +54,7 @@ func TestAccAivenGCPPeeringConnection_basic(t *testing.T) {
 			{
 				Config: testAccGCPVPCPeeringConnection(&s),
 				Check: func(state *terraform.State) error {
-					c := acc.TestAccProvider.Meta().(*aiven.Client)
+					c := acc.TestAccProvider.Meta().(*common.ProviderMeta).Client
 					p := s.AivenProject
 
 				QueryVpc:
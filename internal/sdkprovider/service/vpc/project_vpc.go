@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil/userconfig"
 
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
@@ -53,7 +54,7 @@ func ResourceProjectVPC() *schema.Resource {
 }
 
 func resourceProjectVPCCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	projectName := d.Get("project").(string)
 	vpc, err := client.VPCs.Create(
 		projectName,
@@ -85,7 +86,7 @@ func resourceProjectVPCCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceProjectVPCRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, vpcID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
@@ -106,7 +107,7 @@ func resourceProjectVPCRead(_ context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceProjectVPCDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	projectName, vpcID, err := schemautil.SplitResourceID2(d.Id())
 	if err != nil {
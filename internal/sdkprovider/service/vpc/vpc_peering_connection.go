@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
 	"github.com/aiven/terraform-provider-aiven/internal/schemautil"
 )
 
@@ -23,7 +24,7 @@ func resourceVPCPeeringConnectionCreate(ctx context.Context, d *schema.ResourceD
 		cidrs  []string
 	)
 
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	projectName, vpcID, err := schemautil.SplitResourceID2(d.Get("vpc_id").(string))
 	if err != nil {
 		return diag.FromErr(err)
@@ -183,7 +184,7 @@ func resourceVPCPeeringConnectionRead(_ context.Context, d *schema.ResourceData,
 		return diag.Errorf("error parsing peering VPC ID: %s", err)
 	}
 
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 	isAzure, err := isAzureVPCPeeringConnection(d, client)
 	if err != nil {
 		return diag.Errorf("Error checking if it Azure VPC peering connection: %s", err)
@@ -218,7 +219,7 @@ func resourceVPCPeeringConnectionRead(_ context.Context, d *schema.ResourceData,
 
 // nolint:staticcheck // TODO: Migrate to helper/retry package to avoid deprecated resource.StateRefreshFunc.
 func resourceVPCPeeringConnectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*aiven.Client)
+	client := m.(*common.ProviderMeta).Client
 
 	p, err := parsePeerVPCID(d.Id())
 	if err != nil {
@@ -5,11 +5,14 @@ import (
 	"flag"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 
+	"github.com/aiven/terraform-provider-aiven/internal/common"
+	frameworkprovider "github.com/aiven/terraform-provider-aiven/internal/provider"
 	sdkprovider "github.com/aiven/terraform-provider-aiven/internal/sdkprovider/provider"
 )
 
@@ -25,7 +28,12 @@ func main() {
 
 	ctx := context.Background()
 
-	sdkProvider, err := tf5to6server.UpgradeServer(context.Background(), sdkprovider.Provider(version).GRPCProvider)
+	// The SDK and the framework providers are configured separately, the token cache lets api_token_command run once.
+	tokenCache := common.NewTokenCache()
+
+	sdkProvider, err := tf5to6server.UpgradeServer(
+		context.Background(), sdkprovider.Provider(version, tokenCache).GRPCProvider,
+	)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -34,8 +42,7 @@ func main() {
 		func() tfprotov6.ProviderServer {
 			return sdkProvider
 		},
-		// TODO: Uncomment when this works properly.
-		//providerserver.NewProtocol6(frameworkprovider.New(version)()),
+		providerserver.NewProtocol6(frameworkprovider.New(version, tokenCache)()),
 	}
 
 	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
@@ -51,7 +58,9 @@ func main() {
 
 	if err = tf6server.Serve(
 		"registry.terraform.io/aiven/aiven",
-		muxServer.ProviderServer,
+		func() tfprotov6.ProviderServer {
+			return common.NewSafetyServer(muxServer.ProviderServer())
+		},
 		serveOpts...,
 	); err != nil {
 		log.Fatal(err)